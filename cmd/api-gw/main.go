@@ -27,7 +27,7 @@ func runMain(ctx context.Context) error {
 	}
 
 	wg := sync.WaitGroup{}
-	wg.Add(1)
+	wg.Add(2)
 
 	httpServer := e.ApiGWHTTPServer
 
@@ -37,6 +37,13 @@ func runMain(ctx context.Context) error {
 		httpServer.Close()
 	}()
 
+	go func() {
+		defer wg.Done()
+
+		// истекшие ключи Idempotency-Key, останавливается по ctx
+		e.IdempotencyPurger.Run(ctx)
+	}()
+
 	go func() {
 		defer wg.Done()
 
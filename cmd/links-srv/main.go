@@ -10,7 +10,9 @@ import (
 	"sync"
 	"syscall"
 
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database/links"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/env"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/urlnorm"
 )
 
 func main() {
@@ -27,8 +29,54 @@ func runMain(ctx context.Context) error {
 		return fmt.Errorf("setup.Setup: %w", err)
 	}
 
+	if err := e.LinksRepository.EnsureIndexes(ctx); err != nil {
+		return fmt.Errorf("links EnsureIndexes: %w", err)
+	}
+
+	// после EnsureIndexes: повторы одной страницы отсекает уникальный индекс по canonical_url
+	filled, err := e.LinksRepository.BackfillCanonicalURL(ctx, urlnorm.Normalize)
+	if err != nil {
+		return fmt.Errorf("links BackfillCanonicalURL: %w", err)
+	}
+	if filled > 0 {
+		slog.Info("canonical url backfilled", slog.Int64("links", filled))
+	}
+
+	if schema := e.Config.LinksService.Schema; schema.Validate {
+		if err := e.LinksRepository.EnsureValidator(
+			ctx, links.ValidatorParams{Level: schema.Level, Action: schema.Action},
+		); err != nil {
+			return fmt.Errorf("links EnsureValidator: %w", err)
+		}
+	}
+
 	wg := sync.WaitGroup{}
-	wg.Add(1)
+	wg.Add(5)
+
+	go func() {
+		defer wg.Done()
+
+		// после сигнала завершения дописывает накопленные переходы
+		e.VisitRecorder.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		e.LinksEnricher.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		e.HealthChecker.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		e.LinksPurger.Run(ctx)
+	}()
 
 	grpcServer := e.LinksGRPCServer
 
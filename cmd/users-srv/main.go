@@ -6,6 +6,7 @@ import (
 	"log"
 	"log/slog"
 	"net"
+	"os"
 	"os/signal"
 	"sync"
 	"syscall"
@@ -16,7 +17,14 @@ import (
 func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
-	if err := runMain(ctx); err != nil {
+	run := runMain
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		run = func(ctx context.Context) error {
+			return runMigrate(ctx, os.Args[2:])
+		}
+	}
+
+	if err := run(ctx); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -27,8 +35,28 @@ func runMain(ctx context.Context) error {
 		return fmt.Errorf("setup.Setup: %w", err)
 	}
 
+	if e.Config.UsersService.MigrateOnStart {
+		// реплики применяют миграции по очереди под advisory lock, поэтому одновременный запуск безопасен
+		if _, err := e.UsersMigrator.Up(ctx); err != nil {
+			return fmt.Errorf("migrator Up: %w", err)
+		}
+	}
+
 	wg := sync.WaitGroup{}
-	wg.Add(1)
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+
+		e.UsersPurger.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		// ссылки удаленных пользователей переносятся в корзину отсюда, а не из запроса на удаление
+		e.OutboxRelay.Run(ctx)
+	}()
 
 	grpcServer := e.UsersGRPCServer
 
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/sethvargo/go-envconfig"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database/migrator"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/env/config"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/migrations"
+)
+
+const migrateUsage = "usage: users-srv migrate up | down [N] | status | force VERSION"
+
+// runMigrate подкоманды migrate. Подключается только к Postgres, остальные сервисы для миграций не нужны
+func runMigrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	switch args[0] {
+	case "up", "down", "status", "force":
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	var cfg config.Config
+	if err := envconfig.Process(ctx, &cfg); err != nil { //nolint:typecheck
+		return fmt.Errorf("env processing: %w", err)
+	}
+
+	db, err := pgxpool.Connect(ctx, cfg.UsersService.Postgres.ConnectionURL())
+	if err != nil {
+		return fmt.Errorf("pgxpool Connect: %w", err)
+	}
+	defer db.Close()
+
+	m, err := migrator.New(db, migrations.FS)
+	if err != nil {
+		return fmt.Errorf("migrator New: %w", err)
+	}
+
+	switch cmd, args := args[0], args[1:]; {
+	case cmd == "up" && len(args) == 0:
+		applied, err := m.Up(ctx)
+		if err != nil {
+			return fmt.Errorf("migrator Up: %w", err)
+		}
+		fmt.Printf("applied %d migrations\n", applied)
+	case cmd == "down" && len(args) <= 1:
+		steps := 1
+		if len(args) == 1 {
+			if steps, err = strconv.Atoi(args[0]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[0])
+			}
+		}
+
+		reverted, err := m.Down(ctx, steps)
+		if err != nil {
+			return fmt.Errorf("migrator Down: %w", err)
+		}
+		fmt.Printf("reverted %d migrations\n", reverted)
+	case cmd == "status" && len(args) == 0:
+		st, err := m.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("migrator Status: %w", err)
+		}
+		printStatus(st)
+	case cmd == "force" && len(args) == 1:
+		version, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[0])
+		}
+
+		if err := m.Force(ctx, version); err != nil {
+			return fmt.Errorf("migrator Force: %w", err)
+		}
+		fmt.Printf("version forced to %d\n", version)
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	return nil
+}
+
+func printStatus(st migrator.Status) {
+	switch {
+	case st.Version == migrator.NilVersion:
+		fmt.Println("version: none")
+	case st.Dirty:
+		fmt.Printf("version: %d (dirty)\n", st.Version)
+	default:
+		fmt.Printf("version: %d\n", st.Version)
+	}
+
+	for _, mg := range st.Migrations {
+		state := "pending"
+		if mg.Applied {
+			state = "applied"
+		}
+		fmt.Printf("%06d_%s\t%s\n", mg.Version, mg.Name, state)
+	}
+}
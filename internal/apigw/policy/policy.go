@@ -0,0 +1,113 @@
+package policy
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+)
+
+// Правила доступа api-gw:
+//   - admin может все;
+//   - member читает все, а изменяет только свою учетную запись и ссылки, у которых UserID совпадает с его id;
+//   - read-only только читает;
+//   - корзину удаленных записей каждый видит только свою, чужие и корзину пользователей видит только admin;
+//   - статистику переходов по ссылке видят ее владелец и admin;
+//   - создать пользователя с заданным id может только admin.
+//
+// Отказ возвращается как gRPC статус PermissionDenied, чтобы хэндлеры отдавали его через общий handleGRPCError.
+
+// CanMutateUser проверяет право изменять или удалять пользователя userID
+func CanMutateUser(ctx context.Context, userID string) error {
+	return canMutateOwned(ctx, userID)
+}
+
+// CanMutateLink проверяет право создавать, изменять или удалять ссылку пользователя ownerID
+func CanMutateLink(ctx context.Context, ownerID string) error {
+	return canMutateOwned(ctx, ownerID)
+}
+
+// CanReadTrash проверяет право смотреть удаленные записи пользователя ownerID. Пустой ownerID означает записи всех
+// пользователей
+func CanReadTrash(ctx context.Context, ownerID string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if claims.Role == auth.RoleAdmin || (ownerID != "" && ownerID == claims.UserID()) {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, "only admin can view others' trash")
+}
+
+// CanReadLinkStats проверяет право смотреть статистику переходов по ссылке пользователя ownerID. В ней частота
+// и время посещений, это данные владельца, а не публичная часть ссылки
+func CanReadLinkStats(ctx context.Context, ownerID string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if claims.Role == auth.RoleAdmin || ownerID == claims.UserID() {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, "only owner can view link stats")
+}
+
+// CanAssignRole проверяет право назначить роль. Назначать роли может только admin, остальным разрешено лишь
+// оставить свою текущую роль
+func CanAssignRole(ctx context.Context, role string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		if role == "" || auth.Role(role) == auth.RoleMember {
+			return nil
+		}
+
+		return status.Error(codes.PermissionDenied, "only admin can assign roles")
+	}
+
+	if claims.Role == auth.RoleAdmin || role == "" || auth.Role(role) == claims.Role {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, "only admin can assign roles")
+}
+
+// CanChooseUserID проверяет право создать пользователя с заданным id. Это нужно только для импорта, поэтому
+// доступно одному admin
+func CanChooseUserID(ctx context.Context, id string) error {
+	if id == "" {
+		return nil
+	}
+
+	if claims, ok := auth.ClaimsFromContext(ctx); ok && claims.Role == auth.RoleAdmin {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, "only admin can set user id")
+}
+
+func canMutateOwned(ctx context.Context, ownerID string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	switch claims.Role {
+	case auth.RoleAdmin:
+		return nil
+	case auth.RoleMember:
+		if ownerID == claims.UserID() {
+			return nil
+		}
+
+		return status.Error(codes.PermissionDenied, "members can modify only their own resources")
+	default:
+		return status.Error(codes.PermissionDenied, "role has read-only access")
+	}
+}
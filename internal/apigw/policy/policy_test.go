@@ -0,0 +1,116 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+)
+
+func withSubject(userID string, role auth.Role) context.Context {
+	return auth.WithClaims(
+		context.Background(), auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: userID},
+			Kind:             auth.AccessToken,
+			Role:             role,
+		},
+	)
+}
+
+func TestCanMutateLink(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		ctx   context.Context
+		owner string
+		code  codes.Code
+	}{
+		"admin foreign":     {ctx: withSubject("a", auth.RoleAdmin), owner: "b", code: codes.OK},
+		"member own":        {ctx: withSubject("a", auth.RoleMember), owner: "a", code: codes.OK},
+		"member foreign":    {ctx: withSubject("a", auth.RoleMember), owner: "b", code: codes.PermissionDenied},
+		"read-only own":     {ctx: withSubject("a", auth.RoleReadOnly), owner: "a", code: codes.PermissionDenied},
+		"unknown role":      {ctx: withSubject("a", ""), owner: "a", code: codes.PermissionDenied},
+		"not authenticated": {ctx: context.Background(), owner: "a", code: codes.Unauthenticated},
+	} {
+		require.Equal(t, tc.code, status.Code(CanMutateLink(tc.ctx, tc.owner)), name)
+	}
+}
+
+func TestCanReadTrash(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		ctx   context.Context
+		owner string
+		code  codes.Code
+	}{
+		"admin all":         {ctx: withSubject("a", auth.RoleAdmin), owner: "", code: codes.OK},
+		"member own":        {ctx: withSubject("a", auth.RoleMember), owner: "a", code: codes.OK},
+		"read-only own":     {ctx: withSubject("a", auth.RoleReadOnly), owner: "a", code: codes.OK},
+		"member foreign":    {ctx: withSubject("a", auth.RoleMember), owner: "b", code: codes.PermissionDenied},
+		"member all":        {ctx: withSubject("a", auth.RoleMember), owner: "", code: codes.PermissionDenied},
+		"not authenticated": {ctx: context.Background(), owner: "a", code: codes.Unauthenticated},
+	} {
+		require.Equal(t, tc.code, status.Code(CanReadTrash(tc.ctx, tc.owner)), name)
+	}
+}
+
+func TestCanReadLinkStats(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		ctx   context.Context
+		owner string
+		code  codes.Code
+	}{
+		"admin foreign":     {ctx: withSubject("a", auth.RoleAdmin), owner: "b", code: codes.OK},
+		"member own":        {ctx: withSubject("a", auth.RoleMember), owner: "a", code: codes.OK},
+		"read-only own":     {ctx: withSubject("a", auth.RoleReadOnly), owner: "a", code: codes.OK},
+		"member foreign":    {ctx: withSubject("a", auth.RoleMember), owner: "b", code: codes.PermissionDenied},
+		"not authenticated": {ctx: context.Background(), owner: "a", code: codes.Unauthenticated},
+	} {
+		require.Equal(t, tc.code, status.Code(CanReadLinkStats(tc.ctx, tc.owner)), name)
+	}
+}
+
+func TestCanAssignRole(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		ctx  context.Context
+		role string
+		code codes.Code
+	}{
+		"anonymous default":   {ctx: context.Background(), role: "", code: codes.OK},
+		"anonymous member":    {ctx: context.Background(), role: "member", code: codes.OK},
+		"anonymous admin":     {ctx: context.Background(), role: "admin", code: codes.PermissionDenied},
+		"member keeps own":    {ctx: withSubject("a", auth.RoleMember), role: "member", code: codes.OK},
+		"member escalates":    {ctx: withSubject("a", auth.RoleMember), role: "admin", code: codes.PermissionDenied},
+		"read-only escalates": {ctx: withSubject("a", auth.RoleReadOnly), role: "member", code: codes.PermissionDenied},
+		"admin assigns":       {ctx: withSubject("a", auth.RoleAdmin), role: "read-only", code: codes.OK},
+	} {
+		require.Equal(t, tc.code, status.Code(CanAssignRole(tc.ctx, tc.role)), name)
+	}
+}
+
+func TestCanChooseUserID(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		ctx  context.Context
+		id   string
+		code codes.Code
+	}{
+		"anonymous generated": {ctx: context.Background(), id: "", code: codes.OK},
+		"anonymous chooses":   {ctx: context.Background(), id: "a", code: codes.PermissionDenied},
+		"member chooses":      {ctx: withSubject("a", auth.RoleMember), id: "b", code: codes.PermissionDenied},
+		"admin chooses":       {ctx: withSubject("a", auth.RoleAdmin), id: "b", code: codes.OK},
+	} {
+		require.Equal(t, tc.code, status.Code(CanChooseUserID(tc.ctx, tc.id)), name)
+	}
+}
@@ -0,0 +1,82 @@
+package routes
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/routers"
+
+	v1 "gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/v1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+type tokenParser interface {
+	Parse(token string, kind auth.TokenKind) (auth.Claims, error)
+}
+
+// authenticate проверяет bearer токен раньше validate, чтобы запрос без токена не узнал, что не так с его телом.
+// Нужен ли токен, решает спецификация: операции с security: [] пропускаются без него, как и пути, которых в ней нет,
+// их отклонит сгенерированный роутер. Если на публичную операцию все же пришел валидный токен, его claims попадают
+// в контекст, например чтобы admin мог создать пользователя с ролью
+func authenticate(tokens tokenParser, router routers.Router) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if route, _, ok := findRoute(router, r); !ok || !requiresToken(route) {
+					if token, ok := bearerToken(r); ok {
+						if claims, err := tokens.Parse(token, auth.AccessToken); err == nil {
+							r = r.WithContext(auth.WithClaims(r.Context(), claims))
+						}
+					}
+
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				token, ok := bearerToken(r)
+				if !ok {
+					unauthorized(w, "missing bearer token")
+					return
+				}
+
+				claims, err := tokens.Parse(token, auth.AccessToken)
+				if err != nil {
+					unauthorized(w, "invalid bearer token")
+					return
+				}
+
+				next.ServeHTTP(w, r.WithContext(auth.WithClaims(r.Context(), claims)))
+			},
+		)
+	}
+}
+
+// requiresToken у операции без своего security действуют требования всей спецификации
+func requiresToken(route *routers.Route) bool {
+	security := route.Operation.Security
+	if security == nil {
+		security = &route.Spec.Security
+	}
+
+	return len(*security) > 0
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
+
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	v1.MarshalResponse(
+		w, http.StatusUnauthorized, apiv1.Error{
+			Code:    apiv1.Unauthorized,
+			Message: &message,
+		},
+	)
+}
@@ -0,0 +1,112 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+type stubHandler struct {
+	apiv1.Unimplemented
+}
+
+func (stubHandler) GetUsers(w http.ResponseWriter, r *http.Request, _ apiv1.GetUsersParams) {
+	claims, ok := auth.ClaimsFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write([]byte(claims.UserID()))
+}
+
+func (stubHandler) PostUsers(w http.ResponseWriter, r *http.Request, _ apiv1.PostUsersParams) {
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (stubHandler) GetRCode(w http.ResponseWriter, r *http.Request, code string) {
+	http.Redirect(w, r, "https://ya.ru/"+code, http.StatusFound)
+}
+
+func TestRouter_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	tokens := auth.New([]byte("secret"), "umanager", time.Minute, time.Hour)
+	router, err := Router(stubHandler{}, tokens, ValidationParams{}, IdempotencyParams{})
+	require.NoError(t, err)
+
+	issued, err := tokens.Issue("user-id", auth.RoleMember)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		method        string
+		path          string
+		body          string
+		authorization string
+		code          int
+	}{
+		"public": {
+			method: http.MethodPost,
+			body:   `{"id": "user-id", "username": "user", "password": "password"}`,
+			code:   http.StatusCreated,
+		},
+		"no token":      {method: http.MethodGet, code: http.StatusUnauthorized},
+		"refresh token": {method: http.MethodGet, authorization: "Bearer " + issued.RefreshToken, code: http.StatusUnauthorized},
+		"bad scheme":    {method: http.MethodGet, authorization: "Basic " + issued.AccessToken, code: http.StatusUnauthorized},
+		"access token":  {method: http.MethodGet, authorization: "Bearer " + issued.AccessToken, code: http.StatusOK},
+		// без токена не важно, что запрос еще и не проходит по спецификации
+		"no token, invalid params": {
+			method: http.MethodGet,
+			path:   "/api/v1/users?limit=0",
+			code:   http.StatusUnauthorized,
+		},
+		"public, invalid body": {
+			method: http.MethodPost,
+			body:   `{"username": "user"}`,
+			code:   http.StatusBadRequest,
+		},
+	} {
+		path := tc.path
+		if path == "" {
+			path = "/api/v1/users"
+		}
+
+		req := httptest.NewRequest(tc.method, path, strings.NewReader(tc.body))
+		if tc.body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if tc.authorization != "" {
+			req.Header.Set("Authorization", tc.authorization)
+		}
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		require.Equal(t, tc.code, rec.Code, name)
+		if tc.code == http.StatusOK {
+			require.Equal(t, "user-id", rec.Body.String(), name)
+		}
+	}
+}
+
+func TestRouter_ShortLink(t *testing.T) {
+	t.Parallel()
+
+	tokens := auth.New([]byte("secret"), "umanager", time.Minute, time.Hour)
+	router, err := Router(stubHandler{}, tokens, ValidationParams{}, IdempotencyParams{})
+	require.NoError(t, err)
+
+	for _, path := range []string{"/r/abc123", "/api/v1/r/abc123"} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		require.Equal(t, http.StatusFound, rec.Code, path)
+		require.Equal(t, "https://ya.ru/abc123", rec.Header().Get("Location"), path)
+	}
+}
@@ -0,0 +1,192 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/v1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// replayedHeaders заголовки ответа, которые сохраняются вместе с телом. X-Request-Id у повтора свой
+var replayedHeaders = []string{"Content-Type", "Location", "ETag", "Preference-Applied"}
+
+// fingerprintHeaders заголовки запроса, которые меняют результат: с другим If-Match запись может не пройти, с другим
+// Prefer ответ будет другим
+var fingerprintHeaders = []string{"If-Match", "Prefer"}
+
+type idempotencyStore interface {
+	Reserve(ctx context.Context, rec database.IdempotencyRecord) (database.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, rec database.IdempotencyRecord) error
+	Release(ctx context.Context, key string) error
+}
+
+type IdempotencyParams struct {
+	// Store хранилище ключей. Без него заголовок Idempotency-Key игнорируется
+	Store idempotencyStore
+	// TTL сколько хранится ответ на запрос с ключом
+	TTL time.Duration
+	// Lease на сколько ключ занимает выполняющийся запрос. Если api-gw упал посреди запроса, ключ освободится сам
+	Lease time.Duration
+}
+
+// idempotent повторяет сохраненный ответ на POST и PUT с тем же Idempotency-Key вместо повторного выполнения.
+// Ключ действует в пределах пользователя из токена, поэтому middleware стоит после authenticate. Без токена ключ
+// игнорируется: общая область ключей позволила бы анонимному клиенту получить чужой ответ, угадав ключ. Повтор
+// с другим методом, путем, телом, If-Match или Prefer получает 422, повтор во время выполнения первого запроса 409.
+// Ответы 5xx не сохраняются, чтобы запрос можно было повторить. Эндпоинты /auth не кешируются: в их ответах токены
+func idempotent(params IdempotencyParams) apiv1.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		if params.Store == nil {
+			return next
+		}
+
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				key := r.Header.Get(idempotencyKeyHeader)
+				scope, ok := idempotencyScope(r)
+				if key == "" || !ok || (r.Method != http.MethodPost && r.Method != http.MethodPut) ||
+					strings.HasPrefix(r.URL.Path, basePath+"/auth/") {
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					var tooLarge *http.MaxBytesError
+					if errors.As(err, &tooLarge) {
+						badRequest(w, http.StatusRequestEntityTooLarge, "request body too large", nil)
+						return
+					}
+
+					badRequest(w, http.StatusBadRequest, "read request body", nil)
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+
+				ctx := context.WithoutCancel(r.Context())
+				reserved := database.IdempotencyRecord{
+					Key:         scope + ":" + key,
+					Fingerprint: fingerprint(r, body),
+					ExpiresAt:   time.Now().Add(params.Lease),
+				}
+
+				existing, ok, err := params.Store.Reserve(ctx, reserved)
+				if err != nil {
+					idempotencyError(r, err, "idempotency Reserve")
+					badRequest(w, http.StatusInternalServerError, "internal error", nil)
+					return
+				}
+
+				if !ok {
+					replay(w, existing, reserved.Fingerprint)
+					return
+				}
+
+				rec := &responseRecorder{header: w.Header().Clone(), status: http.StatusOK}
+				next.ServeHTTP(rec, r)
+
+				if rec.status >= http.StatusInternalServerError {
+					if err := params.Store.Release(ctx, reserved.Key); err != nil {
+						idempotencyError(r, err, "idempotency Release")
+					}
+					rec.flush(w)
+					return
+				}
+
+				completed := reserved
+				completed.Status = rec.status
+				completed.Header = make(map[string][]string, len(replayedHeaders))
+				for _, name := range replayedHeaders {
+					if v := rec.header.Values(name); len(v) > 0 {
+						completed.Header[http.CanonicalHeaderKey(name)] = v
+					}
+				}
+				completed.Body = rec.body.Bytes()
+				completed.ExpiresAt = time.Now().Add(params.TTL)
+
+				// запрос уже выполнен, поэтому ответ уходит клиенту, даже если сохранить его не удалось. Ключ
+				// освободится по Lease
+				if err := params.Store.Complete(ctx, completed); err != nil {
+					idempotencyError(r, err, "idempotency Complete")
+				}
+				rec.flush(w)
+			},
+		)
+	}
+}
+
+// replay отвечает на повтор по сохраненной записи
+func replay(w http.ResponseWriter, existing database.IdempotencyRecord, fingerprint string) {
+	if existing.Fingerprint != fingerprint {
+		badRequest(w, http.StatusUnprocessableEntity, "idempotency key is already used with another request", nil)
+		return
+	}
+
+	if !existing.Completed() {
+		retryAfter := math.Ceil(time.Until(existing.ExpiresAt).Seconds())
+		w.Header().Set("Retry-After", strconv.Itoa(max(int(retryAfter), 1)))
+		badRequest(w, http.StatusConflict, "request with this idempotency key is in progress", nil)
+		return
+	}
+
+	for k, v := range existing.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(existing.Status)
+	_, _ = w.Write(existing.Body)
+}
+
+// idempotencyScope пользователь, в пределах которого действует ключ. false, если запрос без токена
+func idempotencyScope(r *http.Request) (string, bool) {
+	claims, ok := auth.ClaimsFromContext(r.Context())
+	if !ok || claims.UserID() == "" {
+		return "", false
+	}
+
+	return claims.UserID(), true
+}
+
+// fingerprint хеш метода, пути с query, заголовков из fingerprintHeaders и тела запроса
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.RequestURI()))
+	h.Write([]byte{0})
+	for _, name := range fingerprintHeaders {
+		h.Write([]byte(strings.Join(r.Header.Values(name), ",")))
+		h.Write([]byte{0})
+	}
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func idempotencyError(r *http.Request, err error, msg string) {
+	slog.Error(
+		msg,
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.String("request_id", r.Header.Get(v1.RequestIDHeader)),
+		slog.String("err", err.Error()),
+	)
+}
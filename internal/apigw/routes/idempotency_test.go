@@ -0,0 +1,150 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database/idempotency"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+type countingHandler struct {
+	apiv1.Unimplemented
+	calls  atomic.Int32
+	status atomic.Int32
+}
+
+func (h *countingHandler) PostLinks(w http.ResponseWriter, r *http.Request, _ apiv1.PostLinksParams) {
+	n := h.calls.Add(1)
+	status := int(h.status.Load())
+	if status >= http.StatusInternalServerError {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/api/v1/links/%d", n))
+	w.Header().Set("ETag", `"1"`)
+	w.Header().Set("X-Debug", "not replayed")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, `{"call": %d}`, n)
+}
+
+func (h *countingHandler) PostUsers(w http.ResponseWriter, _ *http.Request, _ apiv1.PostUsersParams) {
+	h.calls.Add(1)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func TestRouter_Idempotent(t *testing.T) {
+	t.Parallel()
+
+	tokens := auth.New([]byte("secret"), "umanager", time.Minute, time.Hour)
+	handler := &countingHandler{}
+	handler.status.Store(http.StatusCreated)
+	store := idempotency.NewMemory()
+	router, err := Router(
+		handler, tokens, ValidationParams{}, IdempotencyParams{Store: store, TTL: time.Hour, Lease: time.Minute},
+	)
+	require.NoError(t, err)
+
+	alice, err := tokens.Issue("alice", auth.RoleMember)
+	require.NoError(t, err)
+	bob, err := tokens.Issue("bob", auth.RoleMember)
+	require.NoError(t, err)
+
+	postTo := func(path, token, key, body string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if key != "" {
+			req.Header.Set(idempotencyKeyHeader, key)
+		}
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+	post := func(token, key, body string) *httptest.ResponseRecorder {
+		return postTo("/api/v1/links", token, key, body, nil)
+	}
+
+	body := `{"url": "https://ya.ru", "tags": [], "user_id": "alice"}`
+
+	first := post(alice.AccessToken, "key-1", body)
+	require.Equal(t, http.StatusCreated, first.Code)
+	require.Empty(t, first.Header().Get(idempotentReplayedHeader))
+
+	replayed := post(alice.AccessToken, "key-1", body)
+	require.Equal(t, http.StatusCreated, replayed.Code)
+	require.Equal(t, "true", replayed.Header().Get(idempotentReplayedHeader))
+	require.Equal(t, first.Body.String(), replayed.Body.String())
+	require.Equal(t, first.Header().Get("Location"), replayed.Header().Get("Location"))
+	require.Equal(t, first.Header().Get("ETag"), replayed.Header().Get("ETag"))
+	require.Empty(t, replayed.Header().Get("X-Debug"))
+	require.NotEqual(t, first.Header().Get("X-Request-Id"), replayed.Header().Get("X-Request-Id"))
+	require.Equal(t, int32(1), handler.calls.Load())
+
+	// тот же ключ с другим телом
+	mismatch := post(alice.AccessToken, "key-1", `{"url": "https://example.com", "tags": [], "user_id": "alice"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, mismatch.Code)
+	require.Contains(t, mismatch.Body.String(), string(apiv1.BadRequest))
+	require.Equal(t, int32(1), handler.calls.Load())
+
+	// тот же ключ и тело, но другой Prefer: ответ был бы другим
+	minimal := postTo("/api/v1/links", alice.AccessToken, "key-1", body, http.Header{"Prefer": {"return=minimal"}})
+	require.Equal(t, http.StatusUnprocessableEntity, minimal.Code)
+	require.Equal(t, int32(1), handler.calls.Load())
+
+	// ключи разных пользователей не пересекаются, запрос без ключа выполняется всегда
+	require.Equal(t, http.StatusCreated, post(bob.AccessToken, "key-1", body).Code)
+	require.Equal(t, http.StatusCreated, post(alice.AccessToken, "", body).Code)
+	require.Equal(t, int32(3), handler.calls.Load())
+
+	// ответ 5xx не сохраняется, повтор выполняется заново
+	handler.status.Store(http.StatusServiceUnavailable)
+	require.Equal(t, http.StatusServiceUnavailable, post(alice.AccessToken, "key-2", body).Code)
+	handler.status.Store(http.StatusCreated)
+	retried := post(alice.AccessToken, "key-2", body)
+	require.Equal(t, http.StatusCreated, retried.Code)
+	require.Empty(t, retried.Header().Get(idempotentReplayedHeader))
+	require.Equal(t, int32(5), handler.calls.Load())
+
+	// ключ занят запросом, который еще выполняется
+	_, ok, err := store.Reserve(
+		context.Background(), database.IdempotencyRecord{
+			Key:         "alice:key-3",
+			Fingerprint: fingerprint(httptest.NewRequest(http.MethodPost, "/api/v1/links", nil), []byte(body)),
+			ExpiresAt:   time.Now().Add(time.Minute),
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+	inProgress := post(alice.AccessToken, "key-3", body)
+	require.Equal(t, http.StatusConflict, inProgress.Code)
+	require.NotEmpty(t, inProgress.Header().Get("Retry-After"))
+	require.Equal(t, int32(5), handler.calls.Load())
+
+	// без токена ключ не действует: анонимные клиенты не видят ответов друг друга
+	user := `{"username": "user", "password": "password"}`
+	for i := 0; i < 2; i++ {
+		anonymous := postTo("/api/v1/users", "", "key-4", user, nil)
+		require.Equal(t, http.StatusCreated, anonymous.Code)
+		require.Empty(t, anonymous.Header().Get(idempotentReplayedHeader))
+	}
+	require.Equal(t, int32(7), handler.calls.Load())
+}
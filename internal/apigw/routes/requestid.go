@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	v1 "gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/v1"
+)
+
+// maxRequestIDLen длиннее идентификатор от клиента не принимается и заменяется своим
+const maxRequestIDLen = 128
+
+// requestID выставляет в ответ заголовок X-Request-Id. Идентификатор от клиента или прокси сохраняется, если он
+// похож на идентификатор, иначе генерируется новый. По нему ответ с ошибкой находится в логах
+func requestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(v1.RequestIDHeader)
+			if !validRequestID(id) {
+				id = uuid.NewString()
+				r.Header.Set(v1.RequestIDHeader, id)
+			}
+
+			w.Header().Set(v1.RequestIDHeader, id)
+			next.ServeHTTP(w, r)
+		},
+	)
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c == '.':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
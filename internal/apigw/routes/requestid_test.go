@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/v1"
+)
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	var seen string
+	handler := requestID(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				seen = r.Header.Get(v1.RequestIDHeader)
+			},
+		),
+	)
+
+	for name, tc := range map[string]struct {
+		incoming string
+		keep     bool
+	}{
+		"keep":      {incoming: "edge-1f3a.42_x", keep: true},
+		"empty":     {},
+		"too long":  {incoming: strings.Repeat("a", maxRequestIDLen+1)},
+		"injection": {incoming: "id\r\nSet-Cookie: x"},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set(v1.RequestIDHeader, tc.incoming)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		id := w.Header().Get(v1.RequestIDHeader)
+		require.NotEmpty(t, id, name)
+		require.Equal(t, id, seen, name)
+		if tc.keep {
+			require.Equal(t, tc.incoming, id, name)
+		} else {
+			require.NotEqual(t, tc.incoming, id, name)
+		}
+	}
+}
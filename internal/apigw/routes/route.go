@@ -9,18 +9,42 @@ import (
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
 )
 
-// Router has base path /api/v1
-func Router(handler apiv1.ServerInterface) http.Handler {
+// Router has base path /api/v1. Короткие ссылки дополнительно доступны от корня как /r/{code}
+func Router(
+	handler apiv1.ServerInterface,
+	tokens tokenParser,
+	validation ValidationParams,
+	idempotency IdempotencyParams,
+) (http.Handler, error) {
+	spec, err := specRouter()
+	if err != nil {
+		return nil, err
+	}
+
 	router := chi.NewRouter()
+	router.Use(requestID)
+	router.Get(
+		"/r/{code}", func(w http.ResponseWriter, r *http.Request) {
+			handler.GetRCode(w, r, chi.URLParam(r, "code"))
+		},
+	)
+	// authenticate снаружи validate: запрос без токена получает 401 раньше, чем 400 за тело. idempotent нужны claims
 	router.Mount(
-		"/api", apiv1.HandlerWithOptions(
-			handler, apiv1.ChiServerOptions{
-				BaseURL: "/v1",
-				ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-					slog.Error("handle error", slog.String("err", err.Error()))
-				},
-			},
+		"/api", authenticate(tokens, spec)(
+			validate(validation, spec)(
+				apiv1.HandlerWithOptions(
+					handler, apiv1.ChiServerOptions{
+						BaseURL:     "/v1",
+						Middlewares: []apiv1.MiddlewareFunc{idempotent(idempotency)},
+						// параметры уже проверены validate, сюда доходят только расхождения генератора и спецификации
+						ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+							slog.Error("handle error", slog.String("err", err.Error()))
+							badRequest(w, http.StatusBadRequest, err.Error(), nil)
+						},
+					},
+				),
+			),
 		),
 	)
-	return router
+	return router, nil
 }
@@ -0,0 +1,233 @@
+package routes
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+
+	v1 "gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/v1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+// basePath префикс, под которым смонтирован apiv1. В спецификации пути указаны без него
+const basePath = "/api/v1"
+
+func init() {
+	// kin-openapi разбирает только зарегистрированные типы тел, а merge patch это обычный JSON
+	openapi3filter.RegisterBodyDecoder(
+		"application/merge-patch+json", openapi3filter.RegisteredBodyDecoder("application/json"),
+	)
+}
+
+type ValidationParams struct {
+	// Responses проверять и ответы хэндлеров. Ответ, который расходится со спецификацией, заменяется на 500, поэтому
+	// режим нужен в тестах, а не в проде
+	Responses bool
+}
+
+// specRouter ищет операции встроенной спецификации apiv1. Нужен authenticate и validate: сгенерированный роутер
+// находит операцию только после них
+func specRouter() (routers.Router, error) {
+	spec, err := apiv1.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("apiv1 GetSwagger: %w", err)
+	}
+	spec.Servers = nil
+
+	router, err := legacy.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("legacy NewRouter: %w", err)
+	}
+
+	return router, nil
+}
+
+// validate проверяет параметры и тело запроса по спецификации до того, как сгенерированный роутер начнет их
+// разбирать. Запрос, для которого в спецификации нет операции, пропускается: 404 и 405 отдаст chi. Проверка идет
+// после authenticate, поэтому запрос без токена получает 401, даже если он еще и не соответствует спецификации
+func validate(params ValidationParams, router routers.Router) func(http.Handler) http.Handler {
+	options := &openapi3filter.Options{
+		MultiError:            true,
+		IncludeResponseStatus: true,
+		// токен проверяет authenticate, здесь достаточно того, что схема безопасности известна
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				route, pathParams, ok := findRoute(router, r)
+				if !ok {
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				if body := route.Operation.RequestBody; body != nil && body.Value != nil && r.ContentLength != 0 {
+					if contentType := r.Header.Get("Content-Type"); body.Value.Content.Get(contentType) == nil {
+						message := fmt.Sprintf("content-type %q is not supported", contentType)
+						badRequest(w, http.StatusUnsupportedMediaType, message, nil)
+						return
+					}
+				}
+
+				r.Body = http.MaxBytesReader(w, r.Body, v1.MaxBodyBytes)
+				input := &openapi3filter.RequestValidationInput{
+					Request:    r,
+					PathParams: pathParams,
+					Route:      route,
+					Options:    options,
+				}
+				if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+					var tooLarge *http.MaxBytesError
+					if errors.As(err, &tooLarge) {
+						badRequest(w, http.StatusRequestEntityTooLarge, "request body too large", nil)
+						return
+					}
+
+					badRequest(w, http.StatusBadRequest, "request does not match api spec", violations(err))
+					return
+				}
+
+				if !params.Responses {
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				// заголовки, выставленные до validate, нужны хэндлеру, например X-Request-Id
+				rec := &responseRecorder{header: w.Header().Clone(), status: http.StatusOK}
+				next.ServeHTTP(rec, r)
+
+				output := &openapi3filter.ResponseValidationInput{
+					RequestValidationInput: input,
+					Status:                 rec.status,
+					Header:                 rec.header,
+					Options:                options,
+				}
+				output.SetBodyBytes(rec.body.Bytes())
+				if err := openapi3filter.ValidateResponse(r.Context(), output); err != nil {
+					slog.Error(
+						"response does not match api spec",
+						slog.String("method", r.Method),
+						slog.String("path", r.URL.Path),
+						slog.String("err", err.Error()),
+					)
+					message := "response does not match api spec"
+					v1.MarshalResponse(
+						w, http.StatusInternalServerError, apiv1.Error{
+							Code:    apiv1.InternalServerError,
+							Message: &message,
+						},
+					)
+					return
+				}
+
+				rec.flush(w)
+			},
+		)
+	}
+}
+
+// findRoute ищет операцию по пути без basePath
+func findRoute(router routers.Router, r *http.Request) (*routers.Route, map[string]string, bool) {
+	path, ok := strings.CutPrefix(r.URL.Path, basePath)
+	if !ok {
+		return nil, nil, false
+	}
+
+	routed := *r
+	u := *r.URL
+	u.Path = path
+	routed.URL = &u
+
+	route, pathParams, err := router.FindRoute(&routed)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return route, pathParams, true
+}
+
+// violations раскладывает ошибку kin-openapi по полям. Параметр называется по имени, поле тела по пути через точку
+func violations(err error) []apiv1.FieldViolation {
+	var list []apiv1.FieldViolation
+
+	var walk func(err error, field string)
+	walk = func(err error, field string) {
+		switch e := err.(type) {
+		case openapi3.MultiError:
+			for _, err := range e {
+				walk(err, field)
+			}
+		case *openapi3filter.RequestError:
+			switch {
+			case e.Parameter != nil:
+				field = e.Parameter.Name
+			case e.RequestBody != nil:
+				field = "body"
+			}
+			if e.Err == nil {
+				list = append(list, apiv1.FieldViolation{Field: field, Description: e.Reason})
+				return
+			}
+			walk(e.Err, field)
+		case *openapi3.SchemaError:
+			if pointer := e.JSONPointer(); field == "body" && len(pointer) > 0 {
+				field = strings.Join(pointer, ".")
+			}
+			list = append(list, apiv1.FieldViolation{Field: field, Description: e.Reason})
+		default:
+			list = append(list, apiv1.FieldViolation{Field: field, Description: err.Error()})
+		}
+	}
+	walk(err, "")
+
+	return list
+}
+
+func badRequest(w http.ResponseWriter, code int, message string, violations []apiv1.FieldViolation) {
+	resp := apiv1.Error{
+		Code:    v1.ConvertHTTPToErrorCode(code),
+		Message: &message,
+	}
+	if len(violations) > 0 {
+		resp.Violations = &violations
+	}
+
+	v1.MarshalResponse(w, code, resp)
+}
+
+// responseRecorder копит ответ хэндлера, чтобы проверить или сохранить его до отправки клиенту
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+// flush отправляет накопленный ответ в w
+func (r *responseRecorder) flush(w http.ResponseWriter) {
+	for k, v := range r.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body.Bytes())
+}
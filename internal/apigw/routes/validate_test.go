@@ -0,0 +1,136 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/v1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+func TestRouter_ValidateRequest(t *testing.T) {
+	t.Parallel()
+
+	tokens := auth.New([]byte("secret"), "umanager", time.Minute, time.Hour)
+	router, err := Router(stubHandler{}, tokens, ValidationParams{}, IdempotencyParams{})
+	require.NoError(t, err)
+
+	issued, err := tokens.Issue("user-id", auth.RoleMember)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		method      string
+		path        string
+		contentType string
+		body        string
+		code        int
+		violations  []string
+	}{
+		"query": {
+			method:     http.MethodGet,
+			path:       "/api/v1/users?limit=0",
+			code:       http.StatusBadRequest,
+			violations: []string{"limit"},
+		},
+		"body": {
+			method:      http.MethodPost,
+			path:        "/api/v1/links",
+			contentType: "application/json",
+			body:        `{"id": "1", "tags": "news", "user_id": "user-id", "images": [1]}`,
+			code:        http.StatusBadRequest,
+			violations:  []string{"url", "tags", "images.0"},
+		},
+		"merge patch": {
+			method:      http.MethodPatch,
+			path:        "/api/v1/links/1",
+			contentType: "application/merge-patch+json",
+			body:        `{"url": null, "title": null, "color": "red"}`,
+			code:        http.StatusBadRequest,
+			// лишнее свойство kin-openapi относит ко всему телу, имя поля только в описании
+			violations: []string{"url", "body"},
+		},
+		"empty username": {
+			method:      http.MethodPatch,
+			path:        "/api/v1/users/user-id",
+			contentType: "application/merge-patch+json",
+			body:        `{"username": ""}`,
+			code:        http.StatusBadRequest,
+			violations:  []string{"username"},
+		},
+		"content type": {
+			method:      http.MethodPost,
+			path:        "/api/v1/users",
+			contentType: "text/plain",
+			body:        "user",
+			code:        http.StatusUnsupportedMediaType,
+		},
+		"valid": {
+			method: http.MethodGet,
+			path:   "/api/v1/users?limit=10",
+			code:   http.StatusOK,
+		},
+		"not in spec": {
+			method: http.MethodGet,
+			path:   "/api/v1/unknown",
+			code:   http.StatusNotFound,
+		},
+	} {
+		req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+		req.Header.Set("Authorization", "Bearer "+issued.AccessToken)
+		if tc.contentType != "" {
+			req.Header.Set("Content-Type", tc.contentType)
+		}
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		require.Equal(t, tc.code, rec.Code, name)
+
+		if tc.violations == nil {
+			continue
+		}
+		var resp apiv1.Error
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), name)
+		require.Equal(t, apiv1.BadRequest, resp.Code, name)
+		require.Equal(t, rec.Header().Get(v1.RequestIDHeader), *resp.RequestId, name)
+		require.NotNil(t, resp.Violations, name)
+
+		fields := make([]string, 0, len(*resp.Violations))
+		for _, v := range *resp.Violations {
+			require.NotEmpty(t, v.Description, name)
+			fields = append(fields, v.Field)
+		}
+		require.Subset(t, fields, tc.violations, name)
+	}
+}
+
+func TestRouter_ValidateResponse(t *testing.T) {
+	t.Parallel()
+
+	tokens := auth.New([]byte("secret"), "umanager", time.Minute, time.Hour)
+	issued, err := tokens.Issue("user-id", auth.RoleMember)
+	require.NoError(t, err)
+
+	for _, responses := range []bool{false, true} {
+		router, err := Router(stubHandler{}, tokens, ValidationParams{Responses: responses}, IdempotencyParams{})
+		require.NoError(t, err)
+
+		// stubHandler отвечает на GET /users текстом вместо UserList
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		req.Header.Set("Authorization", "Bearer "+issued.AccessToken)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if !responses {
+			require.Equal(t, http.StatusOK, rec.Code)
+			continue
+		}
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+	}
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"net/http"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+func newAuthHandler(usersClient usersClient) *authHandler {
+	return &authHandler{client: usersClient}
+}
+
+type authHandler struct {
+	client usersClient
+}
+
+func (h *authHandler) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req apiv1.LoginRequest
+	code, err := Unmarshal(w, r, &req)
+	if err != nil {
+		errStr := err.Error()
+		MarshalResponse(
+			w, code, apiv1.Error{
+				Code:    ConvertHTTPToErrorCode(code),
+				Message: &errStr,
+			},
+		)
+		return
+	}
+
+	resp, err := h.client.Login(ctx, &pb.LoginRequest{Username: req.Username, Password: req.Password})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	MarshalResponse(w, http.StatusOK, tokenFromPB(resp))
+}
+
+func (h *authHandler) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req apiv1.RefreshRequest
+	code, err := Unmarshal(w, r, &req)
+	if err != nil {
+		errStr := err.Error()
+		MarshalResponse(
+			w, code, apiv1.Error{
+				Code:    ConvertHTTPToErrorCode(code),
+				Message: &errStr,
+			},
+		)
+		return
+	}
+
+	resp, err := h.client.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: req.RefreshToken})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	MarshalResponse(w, http.StatusOK, tokenFromPB(resp))
+}
+
+func tokenFromPB(resp *pb.TokenResponse) apiv1.Token {
+	return apiv1.Token{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		TokenType:    resp.TokenType,
+		ExpiresIn:    resp.ExpiresIn,
+	}
+}
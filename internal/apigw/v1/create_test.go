@@ -0,0 +1,205 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+// creatingLinksClient создает ссылку с переданным id или, если его нет, с фиксированным. legacy отвечает на
+// CreateLink пустым сообщением, как links-srv до того, как CreateLink стал возвращать ссылку
+type creatingLinksClient struct {
+	pb.LinkServiceClient
+	legacy  bool
+	created *pb.CreateLinkRequest
+	read    bool
+}
+
+const generatedLinkID = "65f1c0a2e4b0a1b2c3d4e5f6"
+
+func (c *creatingLinksClient) CreateLink(
+	_ context.Context,
+	in *pb.CreateLinkRequest,
+	_ ...grpc.CallOption,
+) (*pb.Link, error) {
+	c.created = in
+	if c.legacy {
+		return &pb.Link{}, nil
+	}
+
+	id := in.Id
+	if id == "" {
+		id = generatedLinkID
+	}
+
+	return c.link(id), nil
+}
+
+func (c *creatingLinksClient) GetLink(
+	_ context.Context,
+	in *pb.GetLinkRequest,
+	_ ...grpc.CallOption,
+) (*pb.Link, error) {
+	c.read = true
+	return c.link(in.Id), nil
+}
+
+func (c *creatingLinksClient) link(id string) *pb.Link {
+	return &pb.Link{Id: id, Url: c.created.Url, UserId: c.created.UserId, Tags: c.created.Tags, Version: 1}
+}
+
+func TestHandler_PostLinks(t *testing.T) {
+	t.Parallel()
+
+	const userID = "0b9f4a1e-7a3c-4c2e-9d53-1e0c2b6f7a10"
+	ctx := auth.WithClaims(
+		context.Background(), auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: userID},
+			Kind:             auth.AccessToken,
+			Role:             auth.RoleMember,
+		},
+	)
+
+	withID := `{"id": "65f1c0a2e4b0a1b2c3d4e5f7", "url": "https://ya.ru", "tags": [], "user_id": "` + userID + `"}`
+	for name, tc := range map[string]struct {
+		body   string
+		legacy bool
+		id     string
+	}{
+		"generated": {
+			body: `{"url": "https://ya.ru", "tags": [], "user_id": "` + userID + `"}`,
+			id:   generatedLinkID,
+		},
+		"client id": {
+			body: withID,
+			id:   "65f1c0a2e4b0a1b2c3d4e5f7",
+		},
+		"legacy links-srv": {
+			body:   withID,
+			legacy: true,
+			id:     "65f1c0a2e4b0a1b2c3d4e5f7",
+		},
+		// id знает только links-srv, а он его не вернул: ни Location, ни тела
+		"legacy links-srv, generated": {
+			body:   `{"url": "https://ya.ru", "tags": [], "user_id": "` + userID + `"}`,
+			legacy: true,
+		},
+	} {
+		client := &creatingLinksClient{legacy: tc.legacy}
+		h := newLinksHandler(client)
+
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/links", strings.NewReader(tc.body)).WithContext(ctx)
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.PostLinks(w, r, apiv1.PostLinksParams{})
+
+		require.Equal(t, http.StatusCreated, w.Code, name)
+		if tc.id == "" {
+			require.Empty(t, w.Header().Get("Location"), name)
+			require.Empty(t, w.Body.String(), name)
+			require.False(t, client.read, name)
+			continue
+		}
+		require.Equal(t, "/api/v1/links/"+tc.id, w.Header().Get("Location"), name)
+		require.Equal(t, etag(1), w.Header().Get("ETag"), name)
+
+		var link apiv1.Link
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &link), name)
+		require.Equal(t, tc.id, link.Id, name)
+		require.Equal(t, "https://ya.ru", link.Url, name)
+	}
+}
+
+// creatingUsersClient создает пользователя, а id из taken отвечает AlreadyExists, как users-srv при повторной вставке
+type creatingUsersClient struct {
+	pb.UserServiceClient
+	taken   string
+	created *pb.CreateUserRequest
+}
+
+const generatedUserID = "018f2b6a-9c1e-7d3a-8b4f-2a6c0e1d5f70"
+
+func (c *creatingUsersClient) CreateUser(
+	_ context.Context,
+	in *pb.CreateUserRequest,
+	_ ...grpc.CallOption,
+) (*pb.User, error) {
+	c.created = in
+	if in.Id != "" && in.Id == c.taken {
+		return nil, status.Error(codes.AlreadyExists, "user already exists")
+	}
+
+	id := in.Id
+	if id == "" {
+		id = generatedUserID
+	}
+
+	return &pb.User{Id: id, Username: in.Username, Role: "member", Version: 1}, nil
+}
+
+func TestHandler_PostUsers(t *testing.T) {
+	t.Parallel()
+
+	const (
+		chosenID = "0b9f4a1e-7a3c-4c2e-9d53-1e0c2b6f7a10"
+		takenID  = "0b9f4a1e-7a3c-4c2e-9d53-1e0c2b6f7a11"
+	)
+	admin := auth.WithClaims(
+		context.Background(), auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "admin"},
+			Kind:             auth.AccessToken,
+			Role:             auth.RoleAdmin,
+		},
+	)
+
+	for name, tc := range map[string]struct {
+		ctx     context.Context
+		id      string
+		code    int
+		created bool
+	}{
+		"anonymous generated":  {ctx: context.Background(), code: http.StatusCreated, created: true},
+		"anonymous chooses id": {ctx: context.Background(), id: chosenID, code: http.StatusForbidden},
+		"admin chooses id":     {ctx: admin, id: chosenID, code: http.StatusCreated, created: true},
+		// создание только вставляет: занятый id не перезаписывает существующего пользователя
+		"admin takes existing id": {ctx: admin, id: takenID, code: http.StatusConflict, created: true},
+	} {
+		client := &creatingUsersClient{taken: takenID}
+		h := newUsersHandler(client)
+
+		body := `{"username": "user", "password": "password"}`
+		if tc.id != "" {
+			body = `{"id": "` + tc.id + `", "username": "user", "password": "password"}`
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/users", strings.NewReader(body)).WithContext(tc.ctx)
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.PostUsers(w, r, apiv1.PostUsersParams{})
+
+		require.Equal(t, tc.code, w.Code, name)
+		require.Equal(t, tc.created, client.created != nil, name)
+		if tc.code != http.StatusCreated {
+			continue
+		}
+
+		id := tc.id
+		if id == "" {
+			id = generatedUserID
+		}
+		require.Equal(t, "/api/v1/users/"+id, w.Header().Get("Location"), name)
+	}
+}
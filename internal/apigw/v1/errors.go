@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+// RequestIDHeader заголовок с идентификатором запроса. Его же api-gw кладет в request_id ответа с ошибкой
+const RequestIDHeader = "X-Request-Id"
+
+func handleGRPCError(w http.ResponseWriter, err error) {
+	code, resp := grpcError(err)
+	MarshalResponse(w, code, resp)
+}
+
+// grpcError переводит статус сервиса в HTTP код и тело ответа. Сообщение передается клиенту только для кодов, в
+// которых сервис описывает ошибку в запросе. Для остальных оно может содержать внутренние причины и остается в логе
+func grpcError(err error) (int, apiv1.Error) {
+	st := status.Convert(err)
+	resp := apiv1.Error{Code: ConvertGRPCToErrorCode(st.Code())}
+
+	if clientMessage(st.Code()) {
+		resp.Message = optionalString(st.Message())
+	} else {
+		slog.Error(
+			"grpc call failed",
+			slog.String("code", st.Code().String()),
+			slog.String("message", st.Message()),
+		)
+		resp.Message = optionalString(http.StatusText(ConvertGRPCCodeToHTTP(st.Code())))
+	}
+
+	var (
+		details    []apiv1.ErrorDetail
+		violations []apiv1.FieldViolation
+	)
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info := apiv1.ErrorDetail{
+				Type:   apiv1.ErrorInfo,
+				Reason: optionalString(d.Reason),
+				Domain: optionalString(d.Domain),
+			}
+			if len(d.Metadata) > 0 {
+				metadata := d.Metadata
+				info.Metadata = &metadata
+			}
+			details = append(details, info)
+		case *errdetails.ResourceInfo:
+			details = append(
+				details, apiv1.ErrorDetail{
+					Type:         apiv1.ResourceInfo,
+					ResourceType: optionalString(d.ResourceType),
+					ResourceName: optionalString(d.ResourceName),
+				},
+			)
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				violations = append(violations, apiv1.FieldViolation{Field: v.Field, Description: v.Description})
+			}
+		}
+	}
+	if len(details) > 0 {
+		resp.Details = &details
+	}
+	if len(violations) > 0 {
+		resp.Violations = &violations
+	}
+
+	return ConvertGRPCCodeToHTTP(st.Code()), resp
+}
+
+// clientMessage сообщения этих кодов сервисы собирают сами и не кладут в них ошибки драйверов
+func clientMessage(code codes.Code) bool {
+	switch code {
+	case codes.NotFound,
+		codes.AlreadyExists,
+		codes.Aborted,
+		codes.InvalidArgument,
+		codes.FailedPrecondition,
+		codes.OutOfRange,
+		codes.PermissionDenied,
+		codes.Unauthenticated:
+		return true
+	}
+
+	return false
+}
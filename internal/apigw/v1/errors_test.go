@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/grpcerr"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+func TestHandleGRPCError(t *testing.T) {
+	t.Parallel()
+
+	const domain grpcerr.Domain = "links.umanager"
+
+	for name, tc := range map[string]struct {
+		err        error
+		code       int
+		message    string
+		details    []apiv1.ErrorDetail
+		violations []apiv1.FieldViolation
+	}{
+		"not found": {
+			err: domain.Error(
+				codes.NotFound, grpcerr.ReasonNotFound, "link not found", grpcerr.Resource("link", "1"),
+			),
+			code:    http.StatusNotFound,
+			message: "link not found",
+			details: []apiv1.ErrorDetail{
+				{Type: apiv1.ResourceInfo, ResourceType: optionalString("link"), ResourceName: optionalString("1")},
+				{
+					Type:   apiv1.ErrorInfo,
+					Reason: optionalString(grpcerr.ReasonNotFound),
+					Domain: optionalString(string(domain)),
+				},
+			},
+		},
+		"invalid argument": {
+			err:        domain.InvalidArgument("url", "scheme is required"),
+			code:       http.StatusBadRequest,
+			message:    "url: scheme is required",
+			violations: []apiv1.FieldViolation{{Field: "url", Description: "scheme is required"}},
+			details: []apiv1.ErrorDetail{
+				{
+					Type:   apiv1.ErrorInfo,
+					Reason: optionalString(grpcerr.ReasonInvalidArgument),
+					Domain: optionalString(string(domain)),
+				},
+			},
+		},
+		"internal": {
+			err:     status.Error(codes.Internal, "postgres Exec: connection refused"),
+			code:    http.StatusInternalServerError,
+			message: http.StatusText(http.StatusInternalServerError),
+		},
+		"unavailable": {
+			err:     status.Error(codes.Unavailable, "dial tcp 10.0.0.1:9000: connect: connection refused"),
+			code:    http.StatusServiceUnavailable,
+			message: http.StatusText(http.StatusServiceUnavailable),
+		},
+	} {
+		w := httptest.NewRecorder()
+		w.Header().Set(RequestIDHeader, "req-1")
+		handleGRPCError(w, tc.err)
+		require.Equal(t, tc.code, w.Code, name)
+
+		var resp apiv1.Error
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp), name)
+		require.Equal(t, tc.message, derefString(resp.Message), name)
+		require.Equal(t, "req-1", derefString(resp.RequestId), name)
+
+		if tc.details == nil {
+			require.Nil(t, resp.Details, name)
+		} else {
+			require.Equal(t, tc.details, *resp.Details, name)
+		}
+		if tc.violations == nil {
+			require.Nil(t, resp.Violations, name)
+		} else {
+			require.Equal(t, tc.violations, *resp.Violations, name)
+		}
+	}
+}
+
+func TestGRPCError_Unknown(t *testing.T) {
+	t.Parallel()
+
+	// детали, которых api-gw не знает, не ломают ответ
+	st, err := status.New(codes.ResourceExhausted, "quota").WithDetails(&errdetails.QuotaFailure{})
+	require.NoError(t, err)
+
+	code, resp := grpcError(st.Err())
+	require.Equal(t, http.StatusTooManyRequests, code)
+	require.Nil(t, resp.Details)
+	require.Nil(t, resp.Violations)
+}
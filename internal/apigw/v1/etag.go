@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+var errStaleETag = errors.New("etag does not match current version")
+
+// etag сильный ETag из версии записи
+func etag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseIfMatch возвращает ожидаемую версию из If-Match. Без заголовка, с пустым заголовком и для * версия не
+// проверяется. ETag, которые gateway не выдавал (слабые, списки), ни с одной версией не совпадут, поэтому на них сразу
+// ошибка
+func parseIfMatch(value *string) (*int64, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	// пустой заголовок оставляют прокси и клиенты, которые шлют его всегда, а условия в нем нет
+	v := strings.TrimSpace(*value)
+	if v == "" || v == "*" {
+		return nil, nil
+	}
+
+	unquoted, err := strconv.Unquote(v)
+	if err != nil || !strings.HasPrefix(v, `"`) {
+		return nil, errStaleETag
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version < 0 {
+		return nil, errStaleETag
+	}
+
+	return &version, nil
+}
+
+// writeUpdated ответ на PUT и PATCH: 200 с объектом и его новым ETag. С Prefer: return=minimal (RFC 7240) ответ
+// 204 без тела, как до того, как запись стала возвращать объект
+func writeUpdated(w http.ResponseWriter, prefer *string, version int64, body interface{}) {
+	w.Header().Set("ETag", etag(version))
+	if preferMinimal(prefer) {
+		w.Header().Set("Preference-Applied", "return=minimal")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	MarshalResponse(w, http.StatusOK, body)
+}
+
+// preferMinimal ищет return=minimal среди предпочтений через запятую. Параметры после ; не учитываются
+func preferMinimal(prefer *string) bool {
+	if prefer == nil {
+		return false
+	}
+
+	for _, pref := range strings.Split(*prefer, ",") {
+		pref, _, _ = strings.Cut(pref, ";")
+		if strings.EqualFold(strings.Join(strings.Fields(pref), ""), "return=minimal") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleWriteError при записи с If-Match ABORTED означает, что объект успел измениться
+func handleWriteError(w http.ResponseWriter, err error) {
+	if status.Code(err) == codes.Aborted {
+		handleStaleETag(w)
+		return
+	}
+
+	handleGRPCError(w, err)
+}
+
+func handleStaleETag(w http.ResponseWriter) {
+	errStr := errStaleETag.Error()
+	MarshalResponse(
+		w, http.StatusPreconditionFailed, apiv1.Error{
+			Code:    apiv1.PreconditionFailed,
+			Message: &errStr,
+		},
+	)
+}
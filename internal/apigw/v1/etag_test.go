@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(s string) *string { return &s }
+
+	version, err := parseIfMatch(nil)
+	require.NoError(t, err)
+	require.Nil(t, version)
+
+	for _, value := range []string{"*", "", " "} {
+		version, err = parseIfMatch(ptr(value))
+		require.NoError(t, err, value)
+		require.Nil(t, version, value)
+	}
+
+	version, err = parseIfMatch(ptr(etag(7)))
+	require.NoError(t, err)
+	require.Equal(t, int64(7), *version)
+
+	for _, value := range []string{"7", `W/"7"`, `"7", "8"`, `"abc"`, `"-1"`, "`7`"} {
+		_, err := parseIfMatch(ptr(value))
+		require.ErrorIs(t, err, errStaleETag, value)
+	}
+}
+
+func TestHandleWriteError(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	handleWriteError(w, status.Error(codes.Aborted, "version mismatch"))
+	require.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+	var body apiv1.Error
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, apiv1.PreconditionFailed, body.Code)
+
+	w = httptest.NewRecorder()
+	handleWriteError(w, status.Error(codes.AlreadyExists, "duplicate url"))
+	require.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestPreferMinimal(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, preferMinimal(nil))
+	for value, minimal := range map[string]bool{
+		"return=minimal":                true,
+		"Return = Minimal":              true,
+		"respond-async, return=minimal": true,
+		"return=minimal; foo=bar":       true,
+		"return=representation":         false,
+		"handling=lenient":              false,
+		"return=minimalistic, wait=10":  false,
+		"":                              false,
+	} {
+		require.Equal(t, minimal, preferMinimal(&value), value)
+	}
+}
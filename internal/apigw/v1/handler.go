@@ -6,9 +6,10 @@ import (
 	"fmt"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -20,30 +21,27 @@ type serverInterface interface {
 
 var _ serverInterface = (*Handler)(nil)
 
-func New(usersRepository usersClient, linksRepository linksClient) *Handler {
-	return &Handler{usersHandler: newUsersHandler(usersRepository), linksHandler: newLinksHandler(linksRepository)}
+func New(
+	usersRepository usersClient,
+	linksRepository linksClient,
+	redirectStatus int,
+	trustProxyHeaders bool,
+) *Handler {
+	return &Handler{
+		usersHandler:    newUsersHandler(usersRepository),
+		linksHandler:    newLinksHandler(linksRepository),
+		authHandler:     newAuthHandler(usersRepository),
+		profileHandler:  newProfileHandler(usersRepository, linksRepository),
+		redirectHandler: newRedirectHandler(linksRepository, redirectStatus, trustProxyHeaders),
+	}
 }
 
 type Handler struct {
 	*usersHandler
 	*linksHandler
-}
-
-func handleGRPCError(w http.ResponseWriter, err error) {
-	w.Header().Set("Content-Type", "application/json")
-
-	st := status.Convert(err)
-	code := st.Code()
-	w.WriteHeader(ConvertGRPCCodeToHTTP(code))
-	if err := json.NewEncoder(w).Encode(
-		apiv1.Error{
-			Code:    ConvertGRPCToErrorCode(code),
-			Message: nil,
-		},
-	); err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
+	*authHandler
+	*profileHandler
+	*redirectHandler
 }
 
 func ConvertGRPCCodeToHTTP(grpcCode codes.Code) int {
@@ -97,6 +95,10 @@ func ConvertGRPCToErrorCode(grpcCode codes.Code) apiv1.ErrorCode {
 		return apiv1.BadRequest
 	case codes.Aborted, codes.AlreadyExists:
 		return apiv1.Conflict
+	case codes.Unauthenticated:
+		return apiv1.Unauthorized
+	case codes.PermissionDenied:
+		return apiv1.Forbidden
 	}
 
 	return apiv1.InternalServerError
@@ -112,15 +114,30 @@ func ConvertHTTPToErrorCode(code int) apiv1.ErrorCode {
 		return apiv1.BadRequest
 	case http.StatusUnsupportedMediaType:
 		return apiv1.BadRequest
+	case http.StatusUnprocessableEntity:
+		return apiv1.BadRequest
 	case http.StatusConflict:
 		return apiv1.Conflict
+	case http.StatusUnauthorized:
+		return apiv1.Unauthorized
+	case http.StatusForbidden:
+		return apiv1.Forbidden
+	case http.StatusPreconditionFailed:
+		return apiv1.PreconditionFailed
 	}
 	return apiv1.InternalServerError
 }
 
+// MarshalResponse пишет ответ в JSON. В ответ с ошибкой без request_id подставляется идентификатор из заголовка
+// RequestIDHeader, если middleware его выставил
 func MarshalResponse(w http.ResponseWriter, status int, response interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 
+	if e, ok := response.(apiv1.Error); ok && e.RequestId == nil {
+		e.RequestId = optionalString(w.Header().Get(RequestIDHeader))
+		response = e
+	}
+
 	data, err := json.Marshal(response)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -136,6 +153,11 @@ func Unmarshal(w http.ResponseWriter, r *http.Request, data interface{}) (int, e
 		return http.StatusUnsupportedMediaType, fmt.Errorf("content-type is not application/json")
 	}
 
+	return decodeJSON(w, r, data)
+}
+
+// decodeJSON читает из тела ровно один JSON объект, не больше MaxBodyBytes
+func decodeJSON(w http.ResponseWriter, r *http.Request, data interface{}) (int, error) {
 	defer r.Body.Close()
 	r.Body = http.MaxBytesReader(w, r.Body, MaxBodyBytes)
 
@@ -162,7 +184,8 @@ func Unmarshal(w http.ResponseWriter, r *http.Request, data interface{}) (int, e
 		case err.Error() == "http: request body too large":
 			return http.StatusRequestEntityTooLarge, err
 		default:
-			return http.StatusInternalServerError, fmt.Errorf("failed to decode json: %w", err)
+			slog.Warn("decode json", slog.String("err", err.Error()))
+			return http.StatusInternalServerError, errors.New("failed to decode json")
 		}
 	}
 
@@ -172,3 +195,50 @@ func Unmarshal(w http.ResponseWriter, r *http.Request, data interface{}) (int, e
 
 	return http.StatusOK, nil
 }
+
+// location путь к созданной записи. Коллекция берется из пути запроса, поэтому префикс, под которым смонтирован
+// apiv1, здесь знать не нужно
+func location(r *http.Request, id string) string {
+	return strings.TrimSuffix(r.URL.Path, "/") + "/" + url.PathEscape(id)
+}
+
+func pageSize(limit *apiv1.Limit) int32 {
+	if limit == nil {
+		return 0
+	}
+
+	return *limit
+}
+
+func pageToken(cursor *apiv1.Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	return *cursor
+}
+
+// optionalString пустые значения, например токен последней страницы, не отдаем клиенту
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func derefStrings(values *[]string) []string {
+	if values == nil {
+		return nil
+	}
+
+	return *values
+}
@@ -1,9 +1,15 @@
 package v1
 
 import (
+	"context"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/policy"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+	"log/slog"
 	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func newLinksHandler(linksClient linksClient) *linksHandler {
@@ -14,36 +20,62 @@ type linksHandler struct {
 	client linksClient
 }
 
-func (h *linksHandler) GetLinks(w http.ResponseWriter, r *http.Request) {
+func (h *linksHandler) GetLinks(w http.ResponseWriter, r *http.Request, params apiv1.GetLinksParams) {
 	// implemented
 	ctx := r.Context()
 
-	resp, err := h.client.ListLinks(ctx, nil)
+	req := &pb.SearchLinksRequest{PageSize: pageSize(params.Limit), PageToken: pageToken(params.Cursor)}
+	if params.Tag != nil {
+		req.Tags = *params.Tag
+	}
+	if params.TagMatch != nil && *params.TagMatch == apiv1.All {
+		req.TagMatch = pb.TagMatch_TAG_MATCH_ALL
+	}
+	if params.UserId != nil {
+		req.UserId = *params.UserId
+	}
+	if params.CreatedAfter != nil {
+		req.CreatedAfter = params.CreatedAfter.Format(time.RFC3339)
+	}
+	if params.CreatedBefore != nil {
+		req.CreatedBefore = params.CreatedBefore.Format(time.RFC3339)
+	}
+	if params.Q != nil {
+		req.Query = *params.Q
+	}
+	switch {
+	case params.Order != nil && *params.Order == apiv1.Desc:
+		req.Sort = pb.SortOrder_SORT_ORDER_CREATED_DESC
+	case params.Order != nil && *params.Order == apiv1.Relevance,
+		params.Order == nil && req.Query != "":
+		req.Sort = pb.SortOrder_SORT_ORDER_RELEVANCE
+	}
+
+	resp, err := h.client.SearchLinks(ctx, req)
 	if err != nil {
 		handleGRPCError(w, err)
 		return
 	}
 
+	hits := make(map[string]*pb.SearchHit, len(resp.Hits))
+	for _, hit := range resp.Hits {
+		hits[hit.LinkId] = hit
+	}
+
 	linkList := make([]apiv1.Link, 0, len(resp.Links))
 	for _, l := range resp.Links {
-		linkList = append(
-			linkList, apiv1.Link{
-				CreatedAt: l.CreatedAt,
-				Id:        l.Id,
-				Images:    l.Images,
-				Tags:      l.Tags,
-				Title:     l.Title,
-				UpdatedAt: l.UpdatedAt,
-				Url:       l.Url,
-				UserId:    l.UserId,
-			},
-		)
+		link := linkFromPB(l)
+		if hit, ok := hits[l.Id]; ok {
+			link.Score, link.Highlights = &hit.Score, highlightsFromPB(hit)
+		}
+
+		linkList = append(linkList, link)
 	}
 
-	MarshalResponse(w, http.StatusOK, linkList)
+	MarshalResponse(w, http.StatusOK, apiv1.LinkList{Links: linkList, NextCursor: optionalString(resp.NextPageToken)})
 }
 
-func (h *linksHandler) PostLinks(w http.ResponseWriter, r *http.Request) {
+func (h *linksHandler) PostLinks(w http.ResponseWriter, r *http.Request, _ apiv1.PostLinksParams) {
 	// implemented
 	ctx := r.Context()
 
@@ -60,27 +92,57 @@ func (h *linksHandler) PostLinks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := h.client.CreateLink(
+	if err := policy.CanMutateLink(ctx, l.UserId); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	link, err := h.client.CreateLink(
 		ctx, &pb.CreateLinkRequest{
-			Id:     l.Id,
-			Title:  l.Title,
-			Url:    l.Url,
-			Images: l.Images,
-			Tags:   l.Tags,
-			UserId: l.UserId,
+			Id:          derefString(l.Id),
+			Title:       derefString(l.Title),
+			Description: derefString(l.Description),
+			Url:         l.Url,
+			Images:      derefStrings(l.Images),
+			Tags:        l.Tags,
+			UserId:      l.UserId,
+			ShortCode:   derefString(l.ShortCode),
 		},
-	); err != nil {
+	)
+	if err != nil {
 		handleGRPCError(w, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	if link.Id == "" && derefString(l.Id) == "" {
+		// старый links-srv не вернул ссылку, а id сгенерировал сам: перечитать ее не по чему
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	if link, err = h.writtenLink(ctx, link, derefString(l.Id)); err != nil {
+		// ссылка уже создана, повторять POST не нужно: хватит Location
+		slog.Warn("get created link", slog.String("link_id", derefString(l.Id)), slog.Any("err", err))
+		w.Header().Set("Location", location(r, derefString(l.Id)))
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	w.Header().Set("Location", location(r, link.Id))
+
+	w.Header().Set("ETag", etag(link.Version))
+	MarshalResponse(w, http.StatusCreated, linkFromPB(link))
 }
 
 func (h *linksHandler) DeleteLinksId(w http.ResponseWriter, r *http.Request, id string) {
 	// implemented
 	ctx := r.Context()
 
+	if err := h.authorizeLink(ctx, id, policy.CanMutateLink); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
 	if _, err := h.client.DeleteLink(ctx, &pb.DeleteLinkRequest{Id: id}); err != nil {
 		handleGRPCError(w, err)
 		return
@@ -99,23 +161,19 @@ func (h *linksHandler) GetLinksId(w http.ResponseWriter, r *http.Request, id str
 		return
 	}
 
-	MarshalResponse(
-		w, http.StatusOK, apiv1.Link{
-			CreatedAt: link.CreatedAt,
-			Id:        link.Id,
-			Images:    link.Images,
-			Tags:      link.Tags,
-			Title:     link.Title,
-			UpdatedAt: link.UpdatedAt,
-			Url:       link.Url,
-			UserId:    link.UserId,
-		},
-	)
+	w.Header().Set("ETag", etag(link.Version))
+	MarshalResponse(w, http.StatusOK, linkFromPB(link))
 }
 
-func (h *linksHandler) PutLinksId(w http.ResponseWriter, r *http.Request, id string) {
+func (h *linksHandler) PutLinksId(w http.ResponseWriter, r *http.Request, id string, params apiv1.PutLinksIdParams) {
 	// implemented
 	ctx := r.Context()
+	expectedVersion, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		handleStaleETag(w)
+		return
+	}
+
 	var l apiv1.LinkCreate
 	code, err := Unmarshal(w, r, &l)
 	if err != nil {
@@ -129,46 +187,309 @@ func (h *linksHandler) PutLinksId(w http.ResponseWriter, r *http.Request, id str
 		return
 	}
 
-	if _, err := h.client.UpdateLink(
+	// нельзя ни изменить чужую ссылку, ни передать свою другому пользователю
+	if err := h.authorizeLink(ctx, id, policy.CanMutateLink); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+	if err := policy.CanMutateLink(ctx, l.UserId); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	link, err := h.client.UpdateLink(
 		ctx, &pb.UpdateLinkRequest{
-			Id:     l.Id,
-			Title:  l.Title,
-			Url:    l.Url,
-			Images: l.Images,
-			Tags:   l.Tags,
-			UserId: l.UserId,
+			Id:              id,
+			Title:           derefString(l.Title),
+			Description:     derefString(l.Description),
+			Url:             l.Url,
+			Images:          derefStrings(l.Images),
+			Tags:            l.Tags,
+			UserId:          l.UserId,
+			ExpectedVersion: expectedVersion,
+		},
+	)
+	if err != nil {
+		handleWriteError(w, err)
+		return
+	}
+
+	h.writeUpdated(w, r, link, id, params.Prefer)
+}
+
+func (h *linksHandler) PatchLinksId(
+	w http.ResponseWriter,
+	r *http.Request,
+	id string,
+	params apiv1.PatchLinksIdParams,
+) {
+	ctx := r.Context()
+	expectedVersion, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		handleStaleETag(w)
+		return
+	}
+
+	patch, code, err := unmarshalMergePatch(w, r)
+	if err != nil {
+		handleBodyError(w, code, err)
+		return
+	}
+
+	req := &pb.UpdateLinkRequest{
+		Id:              id,
+		ExpectedVersion: expectedVersion,
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: patch.paths()},
+	}
+	if err := patch.decode(
+		map[string]patchField{
+			"title":       {value: &req.Title, nullable: true},
+			"description": {value: &req.Description, nullable: true},
+			"url":         {value: &req.Url},
+			"images":      {value: &req.Images, nullable: true},
+			"tags":        {value: &req.Tags, nullable: true},
+			"user_id":     {value: &req.UserId},
 		},
 	); err != nil {
+		handleBodyError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// как и в PUT: чужую ссылку менять нельзя, а новым владельцем может быть только тот, кому это разрешено
+	if err := h.authorizeLink(ctx, id, policy.CanMutateLink); err != nil {
 		handleGRPCError(w, err)
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	if _, ok := patch["user_id"]; ok {
+		if err := policy.CanMutateLink(ctx, req.UserId); err != nil {
+			handleGRPCError(w, err)
+			return
+		}
+	}
+
+	link, err := h.client.UpdateLink(ctx, req)
+	if err != nil {
+		handleWriteError(w, err)
+		return
+	}
+
+	h.writeUpdated(w, r, link, id, params.Prefer)
 }
 
-func (h *linksHandler) GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string) {
+func (h *linksHandler) GetLinksUserUserID(
+	w http.ResponseWriter,
+	r *http.Request,
+	userID string,
+	params apiv1.GetLinksUserUserIDParams,
+) {
 	// implemented
 	ctx := r.Context()
-	resp, err := h.client.GetLinkByUserID(ctx, &pb.GetLinksByUserId{UserId: userID})
+	resp, err := h.client.GetLinkByUserID(
+		ctx, &pb.GetLinksByUserId{
+			UserId:    userID,
+			PageSize:  pageSize(params.Limit),
+			PageToken: pageToken(params.Cursor),
+		},
+	)
 	if err != nil {
 		handleGRPCError(w, err)
 		return
 	}
 
-	linkList := make([]apiv1.Link, 0, len(resp.Links))
+	MarshalResponse(w, http.StatusOK, linkListFromPB(resp))
+}
+
+func (h *linksHandler) GetLinksBroken(w http.ResponseWriter, r *http.Request, params apiv1.GetLinksBrokenParams) {
+	ctx := r.Context()
+
+	resp, err := h.client.ListBrokenLinks(
+		ctx, &pb.ListBrokenLinksRequest{
+			UserId:    derefString(params.UserId),
+			PageSize:  pageSize(params.Limit),
+			PageToken: pageToken(params.Cursor),
+		},
+	)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	MarshalResponse(w, http.StatusOK, linkListFromPB(resp))
+}
+
+func (h *linksHandler) GetLinksTrash(w http.ResponseWriter, r *http.Request, params apiv1.GetLinksTrashParams) {
+	ctx := r.Context()
+
+	userID := derefString(params.UserId)
+	if err := policy.CanReadTrash(ctx, userID); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	resp, err := h.client.ListTrash(
+		ctx, &pb.ListLinksTrashRequest{
+			UserId:    userID,
+			PageSize:  pageSize(params.Limit),
+			PageToken: pageToken(params.Cursor),
+		},
+	)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	MarshalResponse(w, http.StatusOK, linkListFromPB(resp))
+}
+
+func (h *linksHandler) PostLinksIdRestore(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	// восстановить можно только свою ссылку, владельца смотрим в корзине
+	trashed, err := h.client.GetLink(ctx, &pb.GetLinkRequest{Id: id, Deleted: true})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+	if err := policy.CanMutateLink(ctx, trashed.UserId); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	link, err := h.client.RestoreLink(ctx, &pb.RestoreLinkRequest{Id: id})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	MarshalResponse(w, http.StatusOK, linkFromPB(link))
+}
+
+func (h *linksHandler) GetLinksIdStats(
+	w http.ResponseWriter,
+	r *http.Request,
+	id string,
+	params apiv1.GetLinksIdStatsParams,
+) {
+	ctx := r.Context()
+
+	if err := h.authorizeLink(ctx, id, policy.CanReadLinkStats); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	req := &pb.GetLinkStatsRequest{LinkId: id}
+	if params.From != nil {
+		req.From = params.From.Format(time.RFC3339)
+	}
+	if params.To != nil {
+		req.To = params.To.Format(time.RFC3339)
+	}
+
+	stats, err := h.client.GetLinkStats(ctx, req)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	daily := make([]apiv1.DailyVisits, 0, len(stats.Daily))
+	for _, d := range stats.Daily {
+		daily = append(daily, apiv1.DailyVisits{Date: d.Date, Visits: d.Visits, UniqueVisitors: d.UniqueVisitors})
+	}
+
+	MarshalResponse(
+		w, http.StatusOK, apiv1.LinkStats{
+			LinkId:         stats.LinkId,
+			TotalVisits:    stats.TotalVisits,
+			UniqueVisitors: stats.UniqueVisitors,
+			Daily:          daily,
+		},
+	)
+}
+
+// writtenLink links-srv старых версий отвечает на запись Empty, который читается как пустой Link. Тогда ссылка
+// перечитывается по id из запроса
+func (h *linksHandler) writtenLink(ctx context.Context, link *pb.Link, id string) (*pb.Link, error) {
+	if link.Id != "" {
+		return link, nil
+	}
+
+	return h.client.GetLink(ctx, &pb.GetLinkRequest{Id: id})
+}
+
+func (h *linksHandler) writeUpdated(w http.ResponseWriter, r *http.Request, link *pb.Link, id string, prefer *string) {
+	link, err := h.writtenLink(r.Context(), link, id)
+	if err != nil {
+		// запись уже прошла, ошибка чтения не должна выглядеть как ошибка обновления
+		slog.Warn("get updated link", slog.String("link_id", id), slog.Any("err", err))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	writeUpdated(w, prefer, link.Version, linkFromPB(link))
+}
+
+// authorizeLink проверяет право check на существующую ссылку id по ее текущему владельцу
+func (h *linksHandler) authorizeLink(
+	ctx context.Context,
+	id string,
+	check func(ctx context.Context, ownerID string) error,
+) error {
+	link, err := h.client.GetLink(ctx, &pb.GetLinkRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	return check(ctx, link.UserId)
+}
+
+func linkFromPB(l *pb.Link) apiv1.Link {
+	link := apiv1.Link{
+		CreatedAt:   l.CreatedAt,
+		Id:          l.Id,
+		Images:      l.Images,
+		Tags:        l.Tags,
+		Title:       l.Title,
+		UpdatedAt:   l.UpdatedAt,
+		Url:         l.Url,
+		UserId:      l.UserId,
+		ShortCode:   optionalString(l.ShortCode),
+		Description: optionalString(l.Description),
+		DeletedAt:   optionalString(l.DeletedAt),
+		Version:     l.Version,
+	}
+	if h := l.Health; h != nil {
+		link.Health = &apiv1.LinkHealth{
+			StatusCode:    h.StatusCode,
+			Error:         optionalString(h.Error),
+			RedirectUrl:   optionalString(h.RedirectUrl),
+			CheckedAt:     h.CheckedAt,
+			FailureStreak: h.FailureStreak,
+		}
+	}
+
+	return link
+}
+
+func linkListFromPB(resp *pb.ListLinkResponse) apiv1.LinkList {
+	links := make([]apiv1.Link, 0, len(resp.Links))
 	for _, l := range resp.Links {
-		linkList = append(
-			linkList, apiv1.Link{
-				CreatedAt: l.CreatedAt,
-				Id:        l.Id,
-				Images:    l.Images,
-				Tags:      l.Tags,
-				Title:     l.Title,
-				UpdatedAt: l.UpdatedAt,
-				Url:       l.Url,
-				UserId:    l.UserId,
-			},
-		)
+		links = append(links, linkFromPB(l))
+	}
+
+	return apiv1.LinkList{Links: links, NextCursor: optionalString(resp.NextPageToken)}
+}
+
+func highlightsFromPB(hit *pb.SearchHit) *apiv1.LinkHighlights {
+	var highlights apiv1.LinkHighlights
+	if hit.Title != "" {
+		highlights.Title = &hit.Title
+	}
+	if hit.Url != "" {
+		highlights.Url = &hit.Url
+	}
+	if len(hit.Tags) > 0 {
+		highlights.Tags = &hit.Tags
 	}
 
-	MarshalResponse(w, http.StatusOK, linkList)
+	return &highlights
 }
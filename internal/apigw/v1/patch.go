@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+)
+
+const mergePatchContentType = "application/merge-patch+json"
+
+// mergePatch тело JSON Merge Patch (RFC 7396): поле, которое есть в патче, меняется, null его очищает
+type mergePatch map[string]json.RawMessage
+
+// patchField куда разбирать значение поля патча. Поле, которое нельзя очистить, не принимает null
+type patchField struct {
+	value    interface{}
+	nullable bool
+}
+
+func unmarshalMergePatch(w http.ResponseWriter, r *http.Request) (mergePatch, int, error) {
+	if t := r.Header.Get("content-type"); !strings.HasPrefix(t, mergePatchContentType) {
+		return nil, http.StatusUnsupportedMediaType, fmt.Errorf("content-type is not %s", mergePatchContentType)
+	}
+
+	var patch mergePatch
+	if code, err := decodeJSON(w, r, &patch); err != nil {
+		return nil, code, err
+	}
+	// пустой патч ничего не меняет, а пустая маска в gRPC означает замену всех полей
+	if len(patch) == 0 {
+		return nil, http.StatusBadRequest, fmt.Errorf("patch must not be empty")
+	}
+
+	return patch, http.StatusOK, nil
+}
+
+// handleBodyError ответ на тело запроса, которое не удалось разобрать
+func handleBodyError(w http.ResponseWriter, code int, err error) {
+	errStr := err.Error()
+	MarshalResponse(
+		w, code, apiv1.Error{
+			Code:    ConvertHTTPToErrorCode(code),
+			Message: &errStr,
+		},
+	)
+}
+
+// decode разбирает поля патча в fields. null у nullable поля оставляет нулевое значение
+func (p mergePatch) decode(fields map[string]patchField) error {
+	for _, name := range p.paths() {
+		field, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("unknown field %q", name)
+		}
+
+		raw := p[name]
+		if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			if !field.nullable {
+				return fmt.Errorf("field %q must not be null", name)
+			}
+			continue
+		}
+		if err := json.Unmarshal(raw, field.value); err != nil {
+			return fmt.Errorf("invalid value of field %q", name)
+		}
+	}
+
+	return nil
+}
+
+// paths имена полей патча для FieldMask
+func (p mergePatch) paths() []string {
+	paths := make([]string, 0, len(p))
+	for name := range p {
+		paths = append(paths, name)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+// recordingUsersClient запоминает последний UpdateUserRequest
+type recordingUsersClient struct {
+	pb.UserServiceClient
+	updated *pb.UpdateUserRequest
+}
+
+func (c *recordingUsersClient) UpdateUser(
+	_ context.Context,
+	in *pb.UpdateUserRequest,
+	_ ...grpc.CallOption,
+) (*pb.User, error) {
+	c.updated = in
+	return &pb.User{Id: in.Id, Username: in.Username, Role: "member", Version: 2}, nil
+}
+
+func TestMergePatch_Decode(t *testing.T) {
+	t.Parallel()
+
+	var (
+		title string
+		tags  = []string{"old"}
+		url   string
+	)
+	fields := map[string]patchField{
+		"title": {value: &title, nullable: true},
+		"tags":  {value: &tags, nullable: true},
+		"url":   {value: &url},
+	}
+
+	patch := mergePatch{"title": []byte(`"new"`), "tags": []byte(" null ")}
+	require.NoError(t, patch.decode(fields))
+	require.Equal(t, "new", title)
+	require.Equal(t, []string{"old"}, tags, "null оставляет значение, которое передастся в gRPC как пустое")
+	require.Equal(t, []string{"tags", "title"}, patch.paths())
+
+	require.Error(t, mergePatch{"url": []byte("null")}.decode(fields))
+	require.Error(t, mergePatch{"url": []byte("42")}.decode(fields))
+	require.Error(t, mergePatch{"id": []byte(`"x"`)}.decode(fields))
+}
+
+func TestHandler_PatchUsersId(t *testing.T) {
+	t.Parallel()
+
+	const id = "0b9f4a1e-7a3c-4c2e-9d53-1e0c2b6f7a10"
+	ctx := auth.WithClaims(
+		context.Background(), auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: id},
+			Kind:             auth.AccessToken,
+			Role:             auth.RoleMember,
+		},
+	)
+
+	for name, tc := range map[string]struct {
+		contentType string
+		body        string
+		prefer      string
+		code        int
+		paths       []string
+	}{
+		"username": {
+			contentType: mergePatchContentType,
+			body:        `{"username": "renamed"}`,
+			code:        http.StatusOK,
+			paths:       []string{"username"},
+		},
+		"minimal": {
+			contentType: mergePatchContentType,
+			body:        `{"username": "renamed"}`,
+			prefer:      "handling=strict, return=minimal",
+			code:        http.StatusNoContent,
+			paths:       []string{"username"},
+		},
+		"empty": {contentType: mergePatchContentType, body: `{}`, code: http.StatusBadRequest},
+		"null":  {contentType: mergePatchContentType, body: `{"password": null}`, code: http.StatusBadRequest},
+		"json":  {contentType: "application/json", body: `{"username": "x"}`, code: http.StatusUnsupportedMediaType},
+		"role": {
+			contentType: mergePatchContentType,
+			body:        `{"role": "admin"}`,
+			code:        http.StatusForbidden,
+		},
+	} {
+		tc := tc
+		t.Run(
+			name, func(t *testing.T) {
+				t.Parallel()
+
+				client := &recordingUsersClient{}
+				h := newUsersHandler(client)
+
+				r := httptest.NewRequest(http.MethodPatch, "/users/"+id, strings.NewReader(tc.body)).WithContext(ctx)
+				r.Header.Set("Content-Type", tc.contentType)
+				w := httptest.NewRecorder()
+				h.PatchUsersId(w, r, id, apiv1.PatchUsersIdParams{Prefer: optionalString(tc.prefer)})
+
+				require.Equal(t, tc.code, w.Code, w.Body.String())
+				if tc.paths == nil {
+					require.Nil(t, client.updated)
+					return
+				}
+				require.Equal(t, tc.paths, client.updated.UpdateMask.Paths)
+				require.Equal(t, "renamed", client.updated.Username)
+				require.Equal(t, etag(2), w.Header().Get("ETag"))
+				if tc.code == http.StatusNoContent {
+					require.Empty(t, w.Body.Bytes())
+					return
+				}
+
+				var user apiv1.User
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &user))
+				require.Equal(t, "renamed", user.Username)
+			},
+		)
+	}
+}
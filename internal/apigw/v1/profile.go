@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+const (
+	defaultProfileRecent  = 5
+	defaultProfileTopTags = 10
+	maxProfileItems       = 50
+)
+
+func newProfileHandler(usersClient usersClient, linksClient linksClient) *profileHandler {
+	return &profileHandler{users: usersClient, links: linksClient}
+}
+
+type profileHandler struct {
+	users usersClient
+	links linksClient
+}
+
+// GetUsersIdProfile запрашивает пользователя и его ссылки параллельно. Если один из сервисов недоступен, профиль
+// отдается без его части, а причина попадает в errors. Остальные ошибки users-srv (нет пользователя, неверный id,
+// нет доступа) отдаются как есть: профиль без пользователя для них ничего не значит
+func (h *profileHandler) GetUsersIdProfile(
+	w http.ResponseWriter,
+	r *http.Request,
+	id string,
+	params apiv1.GetUsersIdProfileParams,
+) {
+	ctx := r.Context()
+
+	var (
+		wg       sync.WaitGroup
+		user     *pb.User
+		userErr  error
+		links    *pb.LinksSummary
+		linksErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		user, userErr = h.users.GetUser(ctx, &pb.GetUserRequest{Id: id})
+	}()
+	go func() {
+		defer wg.Done()
+		links, linksErr = h.links.GetLinksSummary(
+			ctx, &pb.GetLinksSummaryRequest{
+				UserId:  id,
+				Recent:  int32(profileLimit(params.Recent, defaultProfileRecent)),
+				TopTags: int32(profileLimit(params.TopTags, defaultProfileTopTags)),
+			},
+		)
+	}()
+	wg.Wait()
+
+	// без пользователя профиль отдается, только если users-srv временно недоступен и ответил links-srv
+	if userErr != nil && (!transient(userErr) || linksErr != nil) {
+		handleGRPCError(w, userErr)
+		return
+	}
+
+	profile := apiv1.UserProfile{Errors: []apiv1.ProfileError{}}
+	if userErr != nil {
+		slog.Warn("profile GetUser", slog.String("user_id", id), slog.Any("err", userErr))
+		profile.Errors = append(profile.Errors, profileError(apiv1.Users, userErr))
+	} else {
+		u := userFromPB(user)
+		profile.User = &u
+	}
+
+	if linksErr != nil {
+		slog.Warn("profile GetLinksSummary", slog.String("user_id", id), slog.Any("err", linksErr))
+		profile.Errors = append(profile.Errors, profileError(apiv1.Links, linksErr))
+	} else {
+		summary := linksSummaryFromPB(links)
+		profile.Links = &summary
+	}
+
+	MarshalResponse(w, http.StatusOK, profile)
+}
+
+// transient ошибки, при которых сервис может ответить на повтор, поэтому профиль отдается без его части
+func transient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal:
+		return true
+	default:
+		return false
+	}
+}
+
+func linksSummaryFromPB(summary *pb.LinksSummary) apiv1.LinksSummary {
+	result := apiv1.LinksSummary{
+		Count:   summary.Count,
+		Recent:  make([]apiv1.Link, 0, len(summary.Recent)),
+		TopTags: make([]apiv1.TagCount, 0, len(summary.TopTags)),
+	}
+	for _, l := range summary.Recent {
+		result.Recent = append(result.Recent, linkFromPB(l))
+	}
+	for _, t := range summary.TopTags {
+		result.TopTags = append(result.TopTags, apiv1.TagCount{Tag: t.Tag, Count: t.Count})
+	}
+
+	return result
+}
+
+func profileError(source apiv1.ProfileErrorSource, err error) apiv1.ProfileError {
+	_, resp := grpcError(err)
+
+	return apiv1.ProfileError{Source: source, Error: resp}
+}
+
+func profileLimit(value *int32, def int) int {
+	switch {
+	case value == nil:
+		return def
+	case *value < 0:
+		return 0
+	case *value > maxProfileItems:
+		return maxProfileItems
+	}
+
+	return int(*value)
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+type stubUsersClient struct {
+	pb.UserServiceClient
+	err error
+}
+
+func (c stubUsersClient) GetUser(_ context.Context, in *pb.GetUserRequest, _ ...grpc.CallOption) (*pb.User, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &pb.User{Id: in.Id, Username: "user", Role: "member"}, nil
+}
+
+// stubLinksClient сводка по count ссылкам, у каждой второй есть тег even
+type stubLinksClient struct {
+	pb.LinkServiceClient
+	count int
+	err   error
+}
+
+func (c stubLinksClient) GetLinksSummary(
+	_ context.Context,
+	in *pb.GetLinksSummaryRequest,
+	_ ...grpc.CallOption,
+) (*pb.LinksSummary, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	resp := &pb.LinksSummary{Count: int64(c.count)}
+	for i := 0; i < c.count && i < int(in.Recent); i++ {
+		resp.Recent = append(resp.Recent, &pb.Link{Id: fmt.Sprint(i), UserId: in.UserId})
+	}
+	tags := []*pb.TagCount{{Tag: "all", Count: int64(c.count)}, {Tag: "even", Count: int64(c.count+1) / 2}}
+	resp.TopTags = tags[:min(len(tags), int(in.TopTags))]
+
+	return resp, nil
+}
+
+func TestHandler_GetUsersIdProfile(t *testing.T) {
+	t.Parallel()
+
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	recent := int32(2)
+
+	for name, tc := range map[string]struct {
+		users  stubUsersClient
+		links  stubLinksClient
+		code   int
+		assert func(t *testing.T, profile apiv1.UserProfile)
+	}{
+		"full": {
+			links: stubLinksClient{count: 5},
+			code:  http.StatusOK,
+			assert: func(t *testing.T, profile apiv1.UserProfile) {
+				require.Empty(t, profile.Errors)
+				require.Equal(t, "user-id", profile.User.Id)
+				require.Equal(t, int64(5), profile.Links.Count)
+				require.Len(t, profile.Links.Recent, 2)
+				require.Equal(t, "0", profile.Links.Recent[0].Id)
+				require.Equal(
+					t, []apiv1.TagCount{{Tag: "all", Count: 5}, {Tag: "even", Count: 3}}, profile.Links.TopTags,
+				)
+			},
+		},
+		"links unavailable": {
+			links: stubLinksClient{err: unavailable},
+			code:  http.StatusOK,
+			assert: func(t *testing.T, profile apiv1.UserProfile) {
+				require.Equal(t, "user-id", profile.User.Id)
+				require.Nil(t, profile.Links)
+				// причина из статуса остается в логе, клиент получает только текст HTTP кода
+				message := http.StatusText(http.StatusServiceUnavailable)
+				require.Equal(
+					t,
+					[]apiv1.ProfileError{
+						{Source: apiv1.Links, Error: apiv1.Error{Code: apiv1.InternalServerError, Message: &message}},
+					},
+					profile.Errors,
+				)
+			},
+		},
+		"users unavailable": {
+			users: stubUsersClient{err: unavailable},
+			links: stubLinksClient{count: 1},
+			code:  http.StatusOK,
+			assert: func(t *testing.T, profile apiv1.UserProfile) {
+				require.Nil(t, profile.User)
+				require.Equal(t, int64(1), profile.Links.Count)
+				require.Len(t, profile.Errors, 1)
+				require.Equal(t, apiv1.Users, profile.Errors[0].Source)
+			},
+		},
+		"user not found": {
+			users: stubUsersClient{err: status.Error(codes.NotFound, "not found")},
+			links: stubLinksClient{},
+			code:  http.StatusNotFound,
+		},
+		"invalid user id": {
+			users: stubUsersClient{err: status.Error(codes.InvalidArgument, "invalid id")},
+			links: stubLinksClient{count: 1},
+			code:  http.StatusBadRequest,
+		},
+		"users timeout": {
+			users: stubUsersClient{err: status.Error(codes.DeadlineExceeded, "deadline exceeded")},
+			links: stubLinksClient{count: 1},
+			code:  http.StatusOK,
+			assert: func(t *testing.T, profile apiv1.UserProfile) {
+				require.Nil(t, profile.User)
+				require.Equal(t, apiv1.Users, profile.Errors[0].Source)
+			},
+		},
+		"both unavailable": {
+			users: stubUsersClient{err: unavailable},
+			links: stubLinksClient{err: unavailable},
+			code:  http.StatusServiceUnavailable,
+		},
+	} {
+		tc := tc
+		t.Run(
+			name, func(t *testing.T) {
+				t.Parallel()
+
+				h := newProfileHandler(tc.users, tc.links)
+				rec := httptest.NewRecorder()
+				h.GetUsersIdProfile(
+					rec,
+					httptest.NewRequest(http.MethodGet, "/users/user-id/profile", nil),
+					"user-id",
+					apiv1.GetUsersIdProfileParams{Recent: &recent},
+				)
+				require.Equal(t, tc.code, rec.Code)
+
+				if tc.assert != nil {
+					var profile apiv1.UserProfile
+					require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &profile))
+					tc.assert(t, profile)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+func newRedirectHandler(linksClient linksClient, status int, trustProxyHeaders bool) *redirectHandler {
+	if status != http.StatusMovedPermanently {
+		status = http.StatusFound
+	}
+
+	return &redirectHandler{client: linksClient, status: status, trustProxyHeaders: trustProxyHeaders}
+}
+
+type redirectHandler struct {
+	client            linksClient
+	status            int
+	trustProxyHeaders bool
+}
+
+func (h *redirectHandler) GetRCode(w http.ResponseWriter, r *http.Request, code string) {
+	ctx := r.Context()
+
+	link, err := h.client.ResolveShortCode(
+		ctx, &pb.ResolveShortCodeRequest{
+			Code: code,
+			Visit: &pb.Visit{
+				Referrer:  r.Referer(),
+				UserAgent: r.UserAgent(),
+				ClientIp:  h.clientIP(r),
+			},
+		},
+	)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// перенаправляем только на абсолютные http(s) адреса: относительный url браузер разрешит от нашего домена
+	u, err := url.Parse(link.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errStr := "link url is not redirectable"
+		MarshalResponse(w, http.StatusNotFound, apiv1.Error{Code: apiv1.NotFound, Message: &errStr})
+		return
+	}
+
+	http.Redirect(w, r, u.String(), h.status)
+}
+
+// clientIP берет адрес из X-Forwarded-For, только если api-gw стоит за доверенным прокси. Иначе клиент мог бы
+// подставить любой адрес и накрутить уникальных посетителей
+func (h *redirectHandler) clientIP(r *http.Request) string {
+	if h.trustProxyHeaders {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			first, _, _ := strings.Cut(forwarded, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
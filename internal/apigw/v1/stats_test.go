@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+type statsLinksClient struct {
+	pb.LinkServiceClient
+	owner string
+	asked bool
+}
+
+func (c *statsLinksClient) GetLink(_ context.Context, in *pb.GetLinkRequest, _ ...grpc.CallOption) (*pb.Link, error) {
+	return &pb.Link{Id: in.Id, UserId: c.owner}, nil
+}
+
+func (c *statsLinksClient) GetLinkStats(
+	_ context.Context,
+	in *pb.GetLinkStatsRequest,
+	_ ...grpc.CallOption,
+) (*pb.LinkStats, error) {
+	c.asked = true
+	return &pb.LinkStats{LinkId: in.LinkId, TotalVisits: 3}, nil
+}
+
+func TestHandler_GetLinksIdStats(t *testing.T) {
+	t.Parallel()
+
+	const owner = "0b9f4a1e-7a3c-4c2e-9d53-1e0c2b6f7a10"
+	for name, tc := range map[string]struct {
+		subject string
+		role    auth.Role
+		code    int
+	}{
+		"owner":     {subject: owner, role: auth.RoleMember, code: http.StatusOK},
+		"admin":     {subject: "admin", role: auth.RoleAdmin, code: http.StatusOK},
+		"stranger":  {subject: "stranger", role: auth.RoleMember, code: http.StatusForbidden},
+		"read-only": {subject: "reader", role: auth.RoleReadOnly, code: http.StatusForbidden},
+	} {
+		ctx := auth.WithClaims(
+			context.Background(), auth.Claims{
+				RegisteredClaims: jwt.RegisteredClaims{Subject: tc.subject},
+				Kind:             auth.AccessToken,
+				Role:             tc.role,
+			},
+		)
+		client := &statsLinksClient{owner: owner}
+		h := newLinksHandler(client)
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/links/1/stats", nil).WithContext(ctx)
+		w := httptest.NewRecorder()
+		h.GetLinksIdStats(w, r, "1", apiv1.GetLinksIdStatsParams{})
+
+		require.Equal(t, tc.code, w.Code, name)
+		require.Equal(t, tc.code == http.StatusOK, client.asked, name)
+	}
+}
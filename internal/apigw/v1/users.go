@@ -1,9 +1,14 @@
 package v1
 
 import (
+	"context"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/policy"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/api/apiv1"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+	"log/slog"
 	"net/http"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func newUsersHandler(usersClient usersClient) *usersHandler {
@@ -14,32 +19,21 @@ type usersHandler struct {
 	client usersClient
 }
 
-func (h *usersHandler) GetUsers(w http.ResponseWriter, r *http.Request) {
+func (h *usersHandler) GetUsers(w http.ResponseWriter, r *http.Request, params apiv1.GetUsersParams) {
 	// implemented
 	ctx := r.Context()
-	resp, err := h.client.ListUsers(ctx, &pb.Empty{})
+	resp, err := h.client.ListUsers(
+		ctx, &pb.ListUsersRequest{PageSize: pageSize(params.Limit), PageToken: pageToken(params.Cursor)},
+	)
 	if err != nil {
 		handleGRPCError(w, err)
 		return
 	}
 
-	userList := make([]apiv1.User, 0, len(resp.Users))
-	for _, u := range resp.Users {
-		userList = append(
-			userList, apiv1.User{
-				CreatedAt: u.CreatedAt,
-				Id:        u.Id,
-				Password:  u.Password,
-				UpdatedAt: u.UpdatedAt,
-				Username:  u.Username,
-			},
-		)
-	}
-
-	MarshalResponse(w, http.StatusOK, userList)
+	MarshalResponse(w, http.StatusOK, userListFromPB(resp))
 }
 
-func (h *usersHandler) PostUsers(w http.ResponseWriter, r *http.Request) {
+func (h *usersHandler) PostUsers(w http.ResponseWriter, r *http.Request, _ apiv1.PostUsersParams) {
 	// implemented
 	ctx := r.Context()
 
@@ -56,24 +50,58 @@ func (h *usersHandler) PostUsers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := h.client.CreateUser(
+	if err := policy.CanChooseUserID(ctx, derefString(u.Id)); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	role := userRole(u)
+	if err := policy.CanAssignRole(ctx, role); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	user, err := h.client.CreateUser(
 		ctx, &pb.CreateUserRequest{
-			Id:       u.Id,
+			Id:       derefString(u.Id),
 			Username: u.Username,
 			Password: u.Password,
+			Role:     role,
 		},
-	); err != nil {
+	)
+	if err != nil {
 		handleGRPCError(w, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	if user.Id == "" && derefString(u.Id) == "" {
+		// старый users-srv не вернул пользователя, а id сгенерировал сам: перечитать его не по чему
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	if user, err = h.writtenUser(ctx, user, derefString(u.Id)); err != nil {
+		// пользователь уже создан, повторять POST не нужно: хватит Location
+		slog.Warn("get created user", slog.String("user_id", derefString(u.Id)), slog.Any("err", err))
+		w.Header().Set("Location", location(r, derefString(u.Id)))
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	w.Header().Set("Location", location(r, user.Id))
+	w.Header().Set("ETag", etag(user.Version))
+	MarshalResponse(w, http.StatusCreated, userFromPB(user))
 }
 
 func (h *usersHandler) DeleteUsersId(w http.ResponseWriter, r *http.Request, id string) {
 	// implemented
 	ctx := r.Context()
 
+	if err := policy.CanMutateUser(ctx, id); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
 	if _, err := h.client.DeleteUser(ctx, &pb.DeleteUserRequest{Id: id}); err != nil {
 		handleGRPCError(w, err)
 		return
@@ -92,20 +120,18 @@ func (h *usersHandler) GetUsersId(w http.ResponseWriter, r *http.Request, id str
 		return
 	}
 
-	MarshalResponse(
-		w, http.StatusOK, apiv1.User{
-			CreatedAt: u.CreatedAt,
-			Id:        u.Id,
-			Password:  u.Password,
-			UpdatedAt: u.UpdatedAt,
-			Username:  u.Username,
-		},
-	)
+	w.Header().Set("ETag", etag(u.Version))
+	MarshalResponse(w, http.StatusOK, userFromPB(u))
 }
 
-func (h *usersHandler) PutUsersId(w http.ResponseWriter, r *http.Request, id string) {
+func (h *usersHandler) PutUsersId(w http.ResponseWriter, r *http.Request, id string, params apiv1.PutUsersIdParams) {
 	// implemented
 	ctx := r.Context()
+	expectedVersion, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		handleStaleETag(w)
+		return
+	}
 
 	var u apiv1.UserCreate
 	code, err := Unmarshal(w, r, &u)
@@ -120,15 +146,172 @@ func (h *usersHandler) PutUsersId(w http.ResponseWriter, r *http.Request, id str
 		return
 	}
 
-	if _, err := h.client.UpdateUser(
+	role := userRole(u)
+	if err := policy.CanMutateUser(ctx, id); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+	if err := policy.CanAssignRole(ctx, role); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// id берем из пути: права проверены именно для него
+	user, err := h.client.UpdateUser(
 		ctx, &pb.UpdateUserRequest{
-			Id:       u.Id,
-			Username: u.Username,
-			Password: u.Password,
+			Id:              id,
+			Username:        u.Username,
+			Password:        u.Password,
+			Role:            role,
+			ExpectedVersion: expectedVersion,
+		},
+	)
+	if err != nil {
+		handleWriteError(w, err)
+		return
+	}
+
+	h.writeUpdated(w, r, user, id, params.Prefer)
+}
+
+func (h *usersHandler) PatchUsersId(
+	w http.ResponseWriter,
+	r *http.Request,
+	id string,
+	params apiv1.PatchUsersIdParams,
+) {
+	ctx := r.Context()
+	expectedVersion, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		handleStaleETag(w)
+		return
+	}
+
+	patch, code, err := unmarshalMergePatch(w, r)
+	if err != nil {
+		handleBodyError(w, code, err)
+		return
+	}
+
+	req := &pb.UpdateUserRequest{
+		Id:              id,
+		ExpectedVersion: expectedVersion,
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: patch.paths()},
+	}
+	if err := patch.decode(
+		map[string]patchField{
+			"username": {value: &req.Username},
+			"password": {value: &req.Password},
+			"role":     {value: &req.Role},
 		},
 	); err != nil {
+		handleBodyError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := policy.CanMutateUser(ctx, id); err != nil {
 		handleGRPCError(w, err)
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	if _, ok := patch["role"]; ok {
+		if err := policy.CanAssignRole(ctx, req.Role); err != nil {
+			handleGRPCError(w, err)
+			return
+		}
+	}
+
+	user, err := h.client.UpdateUser(ctx, req)
+	if err != nil {
+		handleWriteError(w, err)
+		return
+	}
+
+	h.writeUpdated(w, r, user, id, params.Prefer)
+}
+
+func (h *usersHandler) GetUsersTrash(w http.ResponseWriter, r *http.Request, params apiv1.GetUsersTrashParams) {
+	ctx := r.Context()
+
+	if err := policy.CanReadTrash(ctx, ""); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	resp, err := h.client.ListTrash(
+		ctx, &pb.ListUsersTrashRequest{PageSize: pageSize(params.Limit), PageToken: pageToken(params.Cursor)},
+	)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	MarshalResponse(w, http.StatusOK, userListFromPB(resp))
+}
+
+func (h *usersHandler) PostUsersIdRestore(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	if err := policy.CanMutateUser(ctx, id); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	u, err := h.client.RestoreUser(ctx, &pb.RestoreUserRequest{Id: id})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	MarshalResponse(w, http.StatusOK, userFromPB(u))
+}
+
+// writtenUser users-srv старых версий отвечает на запись Empty, который читается как пустой User. Тогда
+// пользователь перечитывается по id из запроса
+func (h *usersHandler) writtenUser(ctx context.Context, user *pb.User, id string) (*pb.User, error) {
+	if user.Id != "" {
+		return user, nil
+	}
+
+	return h.client.GetUser(ctx, &pb.GetUserRequest{Id: id})
+}
+
+func (h *usersHandler) writeUpdated(w http.ResponseWriter, r *http.Request, user *pb.User, id string, prefer *string) {
+	user, err := h.writtenUser(r.Context(), user, id)
+	if err != nil {
+		// запись уже прошла, ошибка чтения не должна выглядеть как ошибка обновления
+		slog.Warn("get updated user", slog.String("user_id", id), slog.Any("err", err))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	writeUpdated(w, prefer, user.Version, userFromPB(user))
+}
+
+func userFromPB(u *pb.User) apiv1.User {
+	return apiv1.User{
+		CreatedAt: u.CreatedAt,
+		Id:        u.Id,
+		Role:      apiv1.Role(u.Role),
+		UpdatedAt: u.UpdatedAt,
+		Username:  u.Username,
+		DeletedAt: optionalString(u.DeletedAt),
+		Version:   u.Version,
+	}
+}
+
+func userListFromPB(resp *pb.ListUsersResponse) apiv1.UserList {
+	users := make([]apiv1.User, 0, len(resp.Users))
+	for _, u := range resp.Users {
+		users = append(users, userFromPB(u))
+	}
+
+	return apiv1.UserList{Users: users, NextCursor: optionalString(resp.NextPageToken)}
+}
+
+func userRole(u apiv1.UserCreate) string {
+	if u.Role == nil {
+		return ""
+	}
+
+	return string(*u.Role)
 }
@@ -0,0 +1,14 @@
+package auth
+
+import "context"
+
+type contextKey struct{}
+
+func WithClaims(ctx context.Context, claims Claims) context.Context {
+	return context.WithValue(ctx, contextKey{}, claims)
+}
+
+func ClaimsFromContext(ctx context.Context) (Claims, bool) {
+	claims, ok := ctx.Value(contextKey{}).(Claims)
+	return claims, ok
+}
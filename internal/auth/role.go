@@ -0,0 +1,20 @@
+package auth
+
+import "fmt"
+
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleMember   Role = "member"
+	RoleReadOnly Role = "read-only"
+)
+
+func ParseRole(s string) (Role, error) {
+	switch r := Role(s); r {
+	case RoleAdmin, RoleMember, RoleReadOnly:
+		return r, nil
+	}
+
+	return "", fmt.Errorf("unknown role %q", s)
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type TokenKind string
+
+const (
+	AccessToken  TokenKind = "access"
+	RefreshToken TokenKind = "refresh"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type Claims struct {
+	jwt.RegisteredClaims
+	Kind TokenKind `json:"kind"`
+	Role Role      `json:"role"`
+}
+
+// UserID идентификатор пользователя, которому выдан токен
+func (c Claims) UserID() string {
+	return c.Subject
+}
+
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+}
+
+func New(secret []byte, issuer string, accessTTL, refreshTTL time.Duration) *TokenManager {
+	return &TokenManager{secret: secret, issuer: issuer, accessTTL: accessTTL, refreshTTL: refreshTTL}
+}
+
+// TokenManager выпускает и проверяет подписанные HS256 access и refresh токены
+type TokenManager struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+func (m *TokenManager) Issue(userID string, role Role) (Tokens, error) {
+	now := time.Now()
+
+	access, err := m.sign(userID, role, AccessToken, now, m.accessTTL)
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	refresh, err := m.sign(userID, role, RefreshToken, now, m.refreshTTL)
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	return Tokens{AccessToken: access, RefreshToken: refresh, ExpiresIn: m.accessTTL}, nil
+}
+
+func (m *TokenManager) Parse(token string, kind TokenKind) (Claims, error) {
+	var claims Claims
+
+	if _, err := jwt.ParseWithClaims(
+		token, &claims, func(*jwt.Token) (interface{}, error) {
+			return m.secret, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return claims, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if claims.Kind != kind {
+		return claims, fmt.Errorf("%w: unexpected token kind %q", ErrInvalidToken, claims.Kind)
+	}
+
+	if claims.Subject == "" {
+		return claims, fmt.Errorf("%w: empty subject", ErrInvalidToken)
+	}
+
+	return claims, nil
+}
+
+func (m *TokenManager) sign(userID string, role Role, kind TokenKind, now time.Time, ttl time.Duration) (string, error) {
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.issuer,
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Kind: kind,
+		Role: role,
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", fmt.Errorf("jwt SignedString: %w", err)
+	}
+
+	return signed, nil
+}
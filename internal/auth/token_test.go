@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenManager_IssueParse(t *testing.T) {
+	t.Parallel()
+
+	m := New([]byte("secret"), "umanager", time.Minute, time.Hour)
+
+	tokens, err := m.Issue("58a06aa0-633e-45bd-976a-c5171413b3ea", RoleMember)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, tokens.ExpiresIn)
+
+	claims, err := m.Parse(tokens.AccessToken, AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, "58a06aa0-633e-45bd-976a-c5171413b3ea", claims.UserID())
+	require.Equal(t, RoleMember, claims.Role)
+
+	claims, err = m.Parse(tokens.RefreshToken, RefreshToken)
+	require.NoError(t, err)
+	require.Equal(t, "58a06aa0-633e-45bd-976a-c5171413b3ea", claims.UserID())
+}
+
+func TestTokenManager_ParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	m := New([]byte("secret"), "umanager", time.Minute, time.Hour)
+
+	tokens, err := m.Issue("58a06aa0-633e-45bd-976a-c5171413b3ea", RoleMember)
+	require.NoError(t, err)
+
+	expired, err := New([]byte("secret"), "umanager", -time.Minute, time.Hour).Issue("user", RoleMember)
+	require.NoError(t, err)
+
+	foreign, err := New([]byte("other"), "umanager", time.Minute, time.Hour).Issue("user", RoleMember)
+	require.NoError(t, err)
+
+	otherIssuer, err := New([]byte("secret"), "other", time.Minute, time.Hour).Issue("user", RoleMember)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		token string
+		kind  TokenKind
+	}{
+		"refresh as access": {token: tokens.RefreshToken, kind: AccessToken},
+		"access as refresh": {token: tokens.AccessToken, kind: RefreshToken},
+		"expired":           {token: expired.AccessToken, kind: AccessToken},
+		"foreign secret":    {token: foreign.AccessToken, kind: AccessToken},
+		"other issuer":      {token: otherIssuer.AccessToken, kind: AccessToken},
+		"malformed":         {token: "not-a-token", kind: AccessToken},
+	} {
+		_, err := m.Parse(tc.token, tc.kind)
+		require.True(t, errors.Is(err, ErrInvalidToken), name)
+	}
+}
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Репозитории оборачивают ошибки драйверов в эти ошибки, чтобы хэндлеры могли проверять их через errors.Is
+var (
+	ErrNotFound = errors.New("not found")
+	ErrConflict = errors.New("conflict")
+	ErrInvalid  = errors.New("invalid")
+
+	// ErrVersionMismatch запись изменилась после того, как клиент ее прочитал
+	ErrVersionMismatch = errors.New("version mismatch")
+
+	// ErrShortCodeTaken частный случай ErrConflict: короткий код уже занят другой ссылкой
+	ErrShortCodeTaken = fmt.Errorf("%w: short code is taken", ErrConflict)
+
+	// ErrDuplicateURL частный случай ErrConflict: у пользователя уже есть ссылка с таким же каноническим url
+	ErrDuplicateURL = fmt.Errorf("%w: link with this url already exists", ErrConflict)
+)
@@ -0,0 +1,19 @@
+package database
+
+import "time"
+
+// IdempotencyRecord запрос api-gw с заголовком Idempotency-Key и ответ на него. Пока первый запрос выполняется,
+// Status равен 0, а ExpiresAt ограничивает время, на которое ключ занят
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint string // хеш метода, пути и тела: повтор с другим телом не должен получить чужой ответ
+	Status      int
+	Header      map[string][]string
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// Completed ответ уже сохранен и его можно повторить
+func (r IdempotencyRecord) Completed() bool {
+	return r.Status != 0
+}
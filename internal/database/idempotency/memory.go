@@ -0,0 +1,75 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+func NewMemory() *Memory {
+	return &Memory{records: make(map[string]database.IdempotencyRecord)}
+}
+
+// Memory ключи идемпотентности в памяти одной реплики api-gw. Подходит для одной реплики и для тестов: после
+// рестарта повторы выполнятся заново
+type Memory struct {
+	mu      sync.Mutex
+	records map[string]database.IdempotencyRecord
+}
+
+// Reserve ведет себя как Repository.Reserve
+func (m *Memory) Reserve(_ context.Context, rec database.IdempotencyRecord) (database.IdempotencyRecord, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.records[rec.Key]; ok && time.Now().Before(existing.ExpiresAt) {
+		return existing, false, nil
+	}
+
+	m.records[rec.Key] = rec
+
+	return rec, true, nil
+}
+
+func (m *Memory) Complete(_ context.Context, rec database.IdempotencyRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, ok := m.records[rec.Key]
+	if !ok || existing.Fingerprint != rec.Fingerprint {
+		return fmt.Errorf("memory Complete: %w", database.ErrNotFound)
+	}
+
+	m.records[rec.Key] = rec
+
+	return nil
+}
+
+func (m *Memory) Release(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.records[key]; ok && !existing.Completed() {
+		delete(m.records, key)
+	}
+
+	return nil
+}
+
+func (m *Memory) PurgeExpired(_ context.Context, before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var purged int64
+	for key, rec := range m.records {
+		if !rec.ExpiresAt.After(before) {
+			delete(m.records, key)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
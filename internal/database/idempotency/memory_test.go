@@ -0,0 +1,105 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+type store interface {
+	Reserve(ctx context.Context, rec database.IdempotencyRecord) (database.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, rec database.IdempotencyRecord) error
+	Release(ctx context.Context, key string) error
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	testStore(t, NewMemory())
+}
+
+// testStore общие проверки Memory и Repository
+func testStore(t *testing.T, s store) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+
+	key := uuid.NewString()
+	reserved := database.IdempotencyRecord{Key: key, Fingerprint: "a", ExpiresAt: now.Add(time.Minute)}
+
+	rec, ok, err := s.Reserve(ctx, reserved)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.False(t, rec.Completed())
+
+	// ключ занят выполняющимся запросом
+	rec, ok, err = s.Reserve(
+		ctx, database.IdempotencyRecord{Key: key, Fingerprint: "b", ExpiresAt: now.Add(time.Minute)},
+	)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, "a", rec.Fingerprint)
+	require.False(t, rec.Completed())
+
+	// чужой fingerprint не завершает запись
+	require.ErrorIs(t, s.Complete(ctx, database.IdempotencyRecord{Key: key, Fingerprint: "b"}), database.ErrNotFound)
+
+	completed := reserved
+	completed.Status = 201
+	completed.Header = map[string][]string{"Location": {"/api/v1/links/1"}}
+	completed.Body = []byte(`{"id":"1"}`)
+	completed.ExpiresAt = now.Add(time.Hour)
+	require.NoError(t, s.Complete(ctx, completed))
+
+	rec, ok, err = s.Reserve(ctx, reserved)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.True(t, rec.Completed())
+	require.Equal(t, completed.Status, rec.Status)
+	require.Equal(t, completed.Header, rec.Header)
+	require.Equal(t, completed.Body, rec.Body)
+
+	// завершенная запись не освобождается
+	require.NoError(t, s.Release(ctx, key))
+	_, ok, err = s.Reserve(ctx, reserved)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// незавершенная освобождается, и ключ можно занять снова
+	released := database.IdempotencyRecord{Key: uuid.NewString(), Fingerprint: "a", ExpiresAt: now.Add(time.Minute)}
+	_, ok, err = s.Reserve(ctx, released)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, s.Release(ctx, released.Key))
+	_, ok, err = s.Reserve(ctx, released)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// истекшая запись заменяется новой
+	expired := database.IdempotencyRecord{Key: uuid.NewString(), Fingerprint: "a", ExpiresAt: now.Add(-time.Second)}
+	_, ok, err = s.Reserve(ctx, expired)
+	require.NoError(t, err)
+	require.True(t, ok)
+	rec, ok, err = s.Reserve(
+		ctx, database.IdempotencyRecord{Key: expired.Key, Fingerprint: "b", ExpiresAt: now.Add(time.Minute)},
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "b", rec.Fingerprint)
+
+	purgedKey := database.IdempotencyRecord{Key: uuid.NewString(), Fingerprint: "a", ExpiresAt: now.Add(-time.Second)}
+	_, _, err = s.Reserve(ctx, purgedKey)
+	require.NoError(t, err)
+	purged, err := s.PurgeExpired(ctx, now)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, purged, int64(1))
+
+	_, ok, err = s.Reserve(ctx, reserved)
+	require.NoError(t, err)
+	require.False(t, ok, "live record must survive purge")
+}
@@ -0,0 +1,44 @@
+package idempotency
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type purgeStore interface {
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Purger удаляет истекшие ключи. Reserve и сам заменяет истекшую запись, но ключи, которые больше не приходят,
+// без Purger копились бы бесконечно
+type Purger struct {
+	store    purgeStore
+	interval time.Duration
+}
+
+func NewPurger(store purgeStore, interval time.Duration) *Purger {
+	return &Purger{store: store, interval: interval}
+}
+
+// Run удаляет истекшие ключи сразу и затем каждые interval до отмены ctx
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := p.store.PurgeExpired(ctx, time.Now())
+		switch {
+		case err != nil && ctx.Err() == nil:
+			slog.Error("idempotency PurgeExpired", slog.Any("err", err))
+		case purged > 0:
+			slog.Info("idempotency keys purged", slog.Int64("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+func New(db *pgxpool.Pool, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+// Repository ключи идемпотентности в Postgres, общие для всех реплик api-gw. Таблица создается миграциями users-srv
+type Repository struct {
+	db      *pgxpool.Pool
+	timeout time.Duration
+}
+
+// Reserve занимает ключ до rec.ExpiresAt. Если ключ уже занят или по нему сохранен ответ, возвращается эта запись
+// и false. Истекшая запись заменяется новой
+func (r *Repository) Reserve(
+	ctx context.Context,
+	rec database.IdempotencyRecord,
+) (database.IdempotencyRecord, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO idempotency_keys (key, fingerprint, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE
+		SET fingerprint = $2, status = 0, header = NULL, body = NULL, expires_at = $3
+		WHERE idempotency_keys.expires_at <= $4
+	`
+	tag, err := r.db.Exec(ctx, query, rec.Key, rec.Fingerprint, rec.ExpiresAt, time.Now())
+	if err != nil {
+		return database.IdempotencyRecord{}, false, fmt.Errorf("postgres Exec: %w", err)
+	}
+	if tag.RowsAffected() == 1 {
+		return rec, true, nil
+	}
+
+	existing, err := r.find(ctx, rec.Key)
+	if err != nil {
+		return database.IdempotencyRecord{}, false, err
+	}
+
+	return existing, false, nil
+}
+
+// Complete сохраняет ответ и продлевает запись до rec.ExpiresAt
+func (r *Repository) Complete(ctx context.Context, rec database.IdempotencyRecord) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	header, err := json.Marshal(rec.Header)
+	if err != nil {
+		return fmt.Errorf("json Marshal: %w", err)
+	}
+
+	query := `
+		UPDATE idempotency_keys SET status = $3, header = $4, body = $5, expires_at = $6
+		WHERE key = $1 AND fingerprint = $2
+	`
+	tag, err := r.db.Exec(ctx, query, rec.Key, rec.Fingerprint, rec.Status, header, rec.Body, rec.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("postgres Exec: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("postgres Exec: %w", database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Release освобождает незавершенный ключ, чтобы запрос можно было повторить
+func (r *Repository) Release(ctx context.Context, key string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE key = $1 AND status = 0`, key); err != nil {
+		return fmt.Errorf("postgres Exec: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeExpired удаляет записи, истекшие к before
+func (r *Repository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tag, err := r.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("postgres Exec: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *Repository) find(ctx context.Context, key string) (database.IdempotencyRecord, error) {
+	rec := database.IdempotencyRecord{Key: key}
+	var header []byte
+
+	query := `SELECT fingerprint, status, header, body, expires_at FROM idempotency_keys WHERE key = $1`
+	if err := r.db.QueryRow(ctx, query, key).Scan(
+		&rec.Fingerprint, &rec.Status, &header, &rec.Body, &rec.ExpiresAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// запись истекла и удалена между INSERT и SELECT
+			return rec, fmt.Errorf("postgres QueryRow: %w: %w", database.ErrNotFound, err)
+		}
+		return rec, fmt.Errorf("postgres QueryRow: %w", err)
+	}
+
+	if header != nil {
+		if err := json.Unmarshal(header, &rec.Header); err != nil {
+			return rec, fmt.Errorf("json Unmarshal: %w", err)
+		}
+	}
+
+	return rec, nil
+}
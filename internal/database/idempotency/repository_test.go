@@ -0,0 +1,37 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/sethvargo/go-envconfig"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database/migrator"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/env/config"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/migrations"
+)
+
+func TestRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	var cfg config.Config
+	require.NoError(t, envconfig.Process(ctx, &cfg)) //nolint:typecheck
+
+	db, err := pgxpool.Connect(ctx, cfg.UsersService.Postgres.ConnectionURL())
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+
+	m, err := migrator.New(db, migrations.FS)
+	require.NoError(t, err)
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	testStore(t, New(db, 5*time.Second))
+}
@@ -7,37 +7,107 @@ import (
 )
 
 type Link struct {
-	ID        primitive.ObjectID `bson:"id"`
-	Title     string             `bson:"title,omitempty"`
-	URL       string             `bson:"url"`
-	Images    []string           `bson:"images"`
-	Tags      []string           `bson:"tags"`
-	UserID    string             `bson:"user_id"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID           primitive.ObjectID `bson:"id"`
+	Title        string             `bson:"title,omitempty"`
+	Description  string             `bson:"description,omitempty"`
+	URL          string             `bson:"url"`
+	CanonicalURL string             `bson:"canonical_url,omitempty"` // форма url для поиска дубликатов, см. urlnorm
+	Images       []string           `bson:"images"`
+	Tags         []string           `bson:"tags"`
+	UserID       string             `bson:"user_id"`
+	ShortCode    string             `bson:"short_code,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at"`
+	Health       *LinkHealth        `bson:"health,omitempty"`     // nil, пока ссылку ни разу не проверяли
+	DeletedAt    *time.Time         `bson:"deleted_at,omitempty"` // не nil, пока ссылка лежит в корзине
+	Score        float64            `bson:"score,omitempty"`      // релевантность, заполняется только при полнотекстовом поиске
+	// растет при каждом изменении полей ссылки, у ссылок, созданных до появления версий, равна 0
+	Version int64 `bson:"version"`
+}
+
+// LinkHealth результат последней проверки доступности url
+type LinkHealth struct {
+	StatusCode    int       `bson:"status_code"` // 0, если ответа не было
+	Error         string    `bson:"error,omitempty"`
+	RedirectURL   string    `bson:"redirect_url,omitempty"` // куда в итоге привели редиректы
+	CheckedAt     time.Time `bson:"checked_at"`
+	FailureStreak int       `bson:"failure_streak"` // неудачных проверок подряд
+}
+
+type HealthCheck struct {
+	OK          bool
+	StatusCode  int
+	Error       string
+	RedirectURL string
+	CheckedAt   time.Time
 }
 
 type CreateLinkReq struct {
-	ID     primitive.ObjectID
-	URL    string
-	Title  string
-	Tags   []string
-	Images []string
-	UserID string
+	ID           primitive.ObjectID
+	URL          string
+	CanonicalURL string
+	Title        string
+	Description  string
+	Tags         []string
+	Images       []string
+	UserID       string
+	ShortCode    string
 }
 
 type UpdateLinkReq struct {
-	ID     primitive.ObjectID
-	URL    string
-	Title  string
-	Tags   []string
-	Images []string
-	UserID string
+	ID           primitive.ObjectID
+	URL          string
+	CanonicalURL string
+	Title        string
+	Description  string
+	Tags         []string
+	Images       []string
+	UserID       string
+	// ExpectedVersion nil означает запись без проверки версии, как раньше
+	ExpectedVersion *int64
+	// Fields поля для частичного обновления (title, description, url, images, tags, user_id). Если список не пуст,
+	// меняются только они
+	Fields []string
+}
+
+// LinkMetadata данные со страницы ссылки. Заполняют только пустые поля: то, что задал пользователь, не меняется
+type LinkMetadata struct {
+	Title       string
+	Description string
+	Images      []string
+}
+
+type SortOrder int
+
+const (
+	SortCreatedAsc SortOrder = iota
+	SortCreatedDesc
+	SortRelevance // только вместе с Query
+)
+
+// LinksSummary сводка по ссылкам пользователя, см. Repository.SummaryByUserID
+type LinksSummary struct {
+	Count   int64
+	Recent  []Link // от новых к старым
+	TopTags []TagCount
+}
+
+type TagCount struct {
+	Tag   string
+	Count int64
 }
 
 type FindLinkCriteria struct {
-	UserID *string
-	Tags   []string
-	Limit  *int64
-	Offset *int64
+	Query         string // полнотекстовый поиск по title, url и tags
+	UserID        *string
+	Tags          []string
+	TagsMatchAll  bool // по умолчанию достаточно совпадения одного тега
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Sort          SortOrder
+	MinFailures   int  // 0 не фильтрует, иначе только ссылки с не меньшим числом неудачных проверок подряд
+	Deleted       bool // искать в корзине. По умолчанию удаленные ссылки не возвращаются
+	Limit         *int64
+	Offset        *int64
+	After         *Cursor // keyset пагинация: только записи строго после курсора в порядке сортировки (created_at, id)
 }
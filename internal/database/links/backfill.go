@@ -0,0 +1,131 @@
+package links
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+const (
+	// migrationsCollection отметки о выполненных разовых миграциях данных
+	migrationsCollection = "migrations"
+
+	canonicalURLBackfill = "canonical_url_backfill"
+)
+
+// BackfillCanonicalURL проставляет canonical_url ссылкам, созданным до его появления, иначе они не находятся при
+// поиске дубликатов. Выполняется один раз: после прохода в migrations остается отметка. Ссылки с url, который не
+// нормализуется, и повторы уже заполненного url того же пользователя остаются без поля и только логируются.
+// Возвращает число заполненных ссылок
+func (r *Repository) BackfillCanonicalURL(ctx context.Context, normalize func(string) (string, error)) (int64, error) {
+	done, err := r.migrationApplied(ctx, canonicalURLBackfill)
+	if err != nil {
+		return 0, err
+	}
+	if done {
+		return 0, nil
+	}
+
+	// курсор проходит всю коллекцию, поэтому таймаут запроса на него не ставится
+	cursor, err := r.db.Collection(collection).Find(
+		ctx,
+		bson.M{"canonical_url": bson.M{"$exists": false}},
+		options.Find().SetProjection(bson.M{"id": 1, "url": 1}),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("mongo Find: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var filled int64
+	for cursor.Next(ctx) {
+		var l struct {
+			ID  primitive.ObjectID `bson:"id"`
+			URL string             `bson:"url"`
+		}
+		if err := cursor.Decode(&l); err != nil {
+			return filled, fmt.Errorf("mongo cursor Decode: %w", err)
+		}
+
+		canonicalURL, err := normalize(l.URL)
+		if err != nil {
+			slog.Warn("canonical url backfill skipped", slog.String("id", l.ID.Hex()), slog.Any("err", err))
+			continue
+		}
+
+		if err := r.setCanonicalURL(ctx, l.ID, canonicalURL); err != nil {
+			if errors.Is(err, database.ErrDuplicateURL) {
+				slog.Warn("canonical url backfill: duplicate link", slog.String("id", l.ID.Hex()))
+				continue
+			}
+
+			return filled, err
+		}
+		filled++
+	}
+	if err := cursor.Err(); err != nil {
+		return filled, fmt.Errorf("mongo cursor: %w", err)
+	}
+
+	if err := r.markMigration(ctx, canonicalURLBackfill); err != nil {
+		return filled, err
+	}
+
+	return filled, nil
+}
+
+func (r *Repository) setCanonicalURL(ctx context.Context, id primitive.ObjectID, canonicalURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	// версия не меняется: содержимое ссылки для клиентов осталось прежним
+	if _, err := r.db.Collection(collection).UpdateOne(
+		ctx,
+		bson.M{"id": id, "canonical_url": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"canonical_url": canonicalURL}},
+	); err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", convertError(err))
+	}
+
+	return nil
+}
+
+func (r *Repository) migrationApplied(ctx context.Context, name string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.db.Collection(migrationsCollection).FindOne(ctx, bson.M{"_id": name}).Err()
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *Repository) markMigration(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Collection(migrationsCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$setOnInsert": bson.M{"applied_at": time.Now()}},
+		options.Update().SetUpsert(true),
+	); err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package links
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/urlnorm"
+)
+
+func TestRepository_BackfillCanonicalURL(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	require.NoError(t, linksRepo.EnsureIndexes(ctx))
+
+	// ссылки, созданные до появления canonical_url
+	userID := uuid.New().String()
+	first, second, invalid := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	for id, url := range map[primitive.ObjectID]string{
+		first:   "https://Example.com/page?b=2&a=1",
+		second:  "https://example.com:443/page?a=1&b=2#top",
+		invalid: "example.com/page",
+	} {
+		_, err := linksRepo.Create(ctx, database.CreateLinkReq{ID: id, URL: url, UserID: userID})
+		require.NoError(t, err)
+	}
+
+	_, err := linksRepo.db.Collection(migrationsCollection).DeleteOne(ctx, bson.M{"_id": canonicalURLBackfill})
+	require.NoError(t, err)
+	filled, err := linksRepo.BackfillCanonicalURL(ctx, urlnorm.Normalize)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, filled, int64(1))
+
+	// из двух одинаковых страниц поле получает одна, вторая остается без него
+	var canonical []string
+	for _, id := range []primitive.ObjectID{first, second, invalid} {
+		l, err := linksRepo.FindByID(ctx, id)
+		require.NoError(t, err)
+		if l.CanonicalURL != "" {
+			canonical = append(canonical, l.CanonicalURL)
+		}
+	}
+	require.Equal(t, []string{"https://example.com/page?a=1&b=2"}, canonical)
+
+	found, err := linksRepo.FindByUserAndURL(ctx, "https://example.com/page?a=1&b=2", userID)
+	require.NoError(t, err)
+	require.Contains(t, []primitive.ObjectID{first, second}, found.ID)
+
+	// повторный запуск ничего не делает
+	filled, err = linksRepo.BackfillCanonicalURL(ctx, urlnorm.Normalize)
+	require.NoError(t, err)
+	require.Zero(t, filled)
+}
@@ -0,0 +1,35 @@
+package links
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+// https://www.mongodb.com/docs/manual/reference/error-codes/
+const namespaceExistsCode = 48
+
+func convertError(err error) error {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return fmt.Errorf("%w: %w", database.ErrNotFound, err)
+	case mongo.IsDuplicateKeyError(err) && strings.Contains(err.Error(), shortCodeIndexName):
+		return fmt.Errorf("%w: %w", database.ErrShortCodeTaken, err)
+	case mongo.IsDuplicateKeyError(err) && strings.Contains(err.Error(), canonicalURLIndexName):
+		return fmt.Errorf("%w: %w", database.ErrDuplicateURL, err)
+	case mongo.IsDuplicateKeyError(err):
+		return fmt.Errorf("%w: %w", database.ErrConflict, err)
+	}
+
+	return err
+}
+
+// isNamespaceExists ошибка CreateCollection для коллекции, которая уже есть
+func isNamespaceExists(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && cmdErr.Code == namespaceExistsCode
+}
@@ -0,0 +1,340 @@
+package links
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	userCreatedIndexName = "links_user_id_created_at_idx"
+	userURLIndexName     = "links_user_id_url_idx"
+	tagsIndexName        = "links_tags_idx"
+
+	// индекс по _id создает сама mongo, в объявлениях его нет
+	defaultIndexName = "_id_"
+)
+
+type DriftKind string
+
+const (
+	DriftMissing DriftKind = "missing" // объявлен, но в базе его нет
+	DriftChanged DriftKind = "changed" // в базе есть индекс с тем же именем, но другими ключами или опциями
+	DriftUnknown DriftKind = "unknown" // есть в базе, но не объявлен
+)
+
+// IndexDrift расхождение между объявленными индексами и индексами в базе
+type IndexDrift struct {
+	Collection string
+	Index      string
+	Kind       DriftKind
+}
+
+type collectionIndexes struct {
+	collection string
+	indexes    []mongo.IndexModel
+}
+
+// declaredIndexes все индексы коллекций links-srv. Имена обязательны: по ним индексы сравниваются с базой
+var declaredIndexes = []collectionIndexes{
+	{
+		collection: collection,
+		indexes: []mongo.IndexModel{
+			// по id ссылку находят все операции, копия с тем же id сделала бы их неоднозначными
+			{
+				Keys:    bson.D{{Key: "id", Value: 1}},
+				Options: options.Index().SetName(idIndexName).SetUnique(true),
+			},
+			// ссылки пользователя в порядке создания
+			{
+				Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}},
+				Options: options.Index().SetName(userCreatedIndexName),
+			},
+			{
+				Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "url", Value: 1}},
+				Options: options.Index().SetName(userURLIndexName),
+			},
+			// фильтр по тегам через $in и $all, полнотекстовый индекс для него не подходит
+			{
+				Keys:    bson.D{{Key: "tags", Value: 1}},
+				Options: options.Index().SetName(tagsIndexName),
+			},
+			{
+				Keys: bson.D{{Key: "title", Value: "text"}, {Key: "url", Value: "text"}, {Key: "tags", Value: "text"}},
+				Options: options.Index().
+					SetName(textIndexName).
+					SetWeights(bson.D{{Key: "title", Value: 10}, {Key: "tags", Value: 5}, {Key: "url", Value: 3}}).
+					SetDefaultLanguage("none"), // без стемминга: ищем и по словам из url, и по русским заголовкам
+			},
+			// ссылки, созданные до появления коротких кодов, кода не имеют и в индекс не попадают
+			{
+				Keys: bson.D{{Key: "short_code", Value: 1}},
+				Options: options.Index().
+					SetName(shortCodeIndexName).
+					SetUnique(true).
+					SetPartialFilterExpression(bson.M{"short_code": bson.M{"$type": "string"}}),
+			},
+			// одна и та же страница у пользователя хранится один раз. Ссылки без канонического url в индекс не
+			// попадают. deleted_at в ключе: у живых ссылок он null и уникальность действует, а удаленные не мешают
+			// сохранить url заново
+			{
+				Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "canonical_url", Value: 1}, {Key: "deleted_at", Value: 1}},
+				Options: options.Index().
+					SetName(canonicalURLIndexName).
+					SetUnique(true).
+					SetPartialFilterExpression(bson.M{"canonical_url": bson.M{"$type": "string"}}),
+			},
+			// очередь проверок доступности: сначала непроверенные ссылки (поля нет), затем давно проверенные
+			{
+				Keys:    bson.D{{Key: "health.checked_at", Value: 1}},
+				Options: options.Index().SetName(healthIndexName),
+			},
+			// в индекс попадают только ссылки с неудачными проверками, а их обычно немного
+			{
+				Keys: bson.D{{Key: "health.failure_streak", Value: 1}},
+				Options: options.Index().
+					SetName(brokenIndexName).
+					SetPartialFilterExpression(bson.M{"health.failure_streak": bson.M{"$gt": 0}}),
+			},
+			// корзина и очистка от старых удаленных ссылок
+			{
+				Keys: bson.D{{Key: "deleted_at", Value: 1}},
+				Options: options.Index().
+					SetName(deletedIndexName).
+					SetPartialFilterExpression(bson.M{"deleted_at": bson.M{"$type": "date"}}),
+			},
+		},
+	},
+	{
+		collection: visitsCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "link_id", Value: 1}, {Key: "visited_at", Value: 1}},
+				Options: options.Index().SetName(visitsLinkIndexName),
+			},
+		},
+	},
+}
+
+// indexInfo индекс в том виде, в котором его возвращает listIndexes
+type indexInfo struct {
+	Name                    string `bson:"name"`
+	Key                     bson.D `bson:"key"`
+	Unique                  bool   `bson:"unique"`
+	PartialFilterExpression bson.M `bson:"partialFilterExpression"`
+	Weights                 bson.M `bson:"weights"`          // только у полнотекстового индекса
+	DefaultLanguage         string `bson:"default_language"` // только у полнотекстового индекса
+}
+
+// EnsureIndexes создает недостающие индексы. Индексы, которые в базе отличаются от объявленных, не пересоздаются:
+// перестроение уникального индекса на большой коллекции решается вручную, поэтому расхождения только логируются
+func (r *Repository) EnsureIndexes(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	drift, err := r.checkIndexes(ctx)
+	if err != nil {
+		return err
+	}
+
+	missing := make(map[string]struct{})
+	for _, d := range drift {
+		if d.Kind == DriftMissing {
+			missing[d.Collection+"."+d.Index] = struct{}{}
+			continue
+		}
+		slog.Warn(
+			"mongo index drift",
+			slog.String("collection", d.Collection),
+			slog.String("index", d.Index),
+			slog.String("kind", string(d.Kind)),
+		)
+	}
+
+	for _, declared := range declaredIndexes {
+		models := make([]mongo.IndexModel, 0, len(declared.indexes))
+		for _, model := range declared.indexes {
+			if _, ok := missing[declared.collection+"."+*model.Options.Name]; ok {
+				models = append(models, model)
+			}
+		}
+		if len(models) == 0 {
+			continue
+		}
+
+		if _, err := r.db.Collection(declared.collection).Indexes().CreateMany(ctx, models); err != nil {
+			return fmt.Errorf("mongo CreateMany %s indexes: %w", declared.collection, err)
+		}
+	}
+
+	return nil
+}
+
+// CheckIndexes сравнивает объявленные индексы с индексами в базе, ничего не меняя
+func (r *Repository) CheckIndexes(ctx context.Context) ([]IndexDrift, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	return r.checkIndexes(ctx)
+}
+
+func (r *Repository) checkIndexes(ctx context.Context) ([]IndexDrift, error) {
+	var drift []IndexDrift
+	for _, declared := range declaredIndexes {
+		// для несуществующей коллекции драйвер возвращает пустой список
+		cursor, err := r.db.Collection(declared.collection).Indexes().List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("mongo List %s indexes: %w", declared.collection, err)
+		}
+
+		var list []indexInfo
+		if err := cursor.All(ctx, &list); err != nil {
+			return nil, fmt.Errorf("mongo cursor All: %w", err)
+		}
+
+		actual := make(map[string]indexInfo, len(list))
+		for _, info := range list {
+			actual[info.Name] = info
+		}
+
+		for _, model := range declared.indexes {
+			name := *model.Options.Name
+			info, ok := actual[name]
+			delete(actual, name)
+
+			switch {
+			case !ok:
+				drift = append(drift, IndexDrift{Collection: declared.collection, Index: name, Kind: DriftMissing})
+			case !indexMatches(model, info):
+				drift = append(drift, IndexDrift{Collection: declared.collection, Index: name, Kind: DriftChanged})
+			}
+		}
+
+		delete(actual, defaultIndexName)
+		for _, info := range list {
+			if _, ok := actual[info.Name]; ok {
+				drift = append(drift, IndexDrift{Collection: declared.collection, Index: info.Name, Kind: DriftUnknown})
+			}
+		}
+	}
+
+	return drift, nil
+}
+
+// indexMatches сравнивает ключи, уникальность и частичный фильтр. Числа сравниваются по значению: индекс, созданный
+// из mongosh, хранит 1 как double
+func indexMatches(model mongo.IndexModel, info indexInfo) bool {
+	if unique := model.Options.Unique != nil && *model.Options.Unique; unique != info.Unique {
+		return false
+	}
+
+	var partial interface{}
+	if model.Options.PartialFilterExpression != nil {
+		partial = normalize(roundTrip(model.Options.PartialFilterExpression))
+	}
+	if info.PartialFilterExpression != nil {
+		if !reflect.DeepEqual(partial, normalize(info.PartialFilterExpression)) {
+			return false
+		}
+	} else if partial != nil {
+		return false
+	}
+
+	keys, _ := roundTrip(model.Keys).(bson.M)
+	for _, v := range keys {
+		// ключи полнотекстового индекса mongo хранит в своем виде (_fts, _ftsx), поля и их веса лежат в weights
+		if v == "text" {
+			return textIndexMatches(model, keys, info)
+		}
+	}
+
+	declared, ok := model.Keys.(bson.D)
+	if !ok || len(declared) != len(info.Key) {
+		return false
+	}
+	for i, e := range declared {
+		if e.Key != info.Key[i].Key || !reflect.DeepEqual(normalize(e.Value), normalize(info.Key[i].Value)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// textIndexMatches сравнивает поля полнотекстового индекса с весами и язык. Поле без явного веса mongo хранит с весом
+// 1, а язык без явного указания english
+func textIndexMatches(model mongo.IndexModel, keys bson.M, info indexInfo) bool {
+	weights := bson.M{}
+	for k, v := range keys {
+		if v == "text" {
+			weights[k] = 1
+		}
+	}
+	if model.Options.Weights != nil {
+		declared, _ := roundTrip(model.Options.Weights).(bson.M)
+		for k, v := range declared {
+			weights[k] = v
+		}
+	}
+	if !reflect.DeepEqual(normalize(weights), normalize(info.Weights)) {
+		return false
+	}
+
+	language := "english"
+	if model.Options.DefaultLanguage != nil {
+		language = *model.Options.DefaultLanguage
+	}
+
+	return language == info.DefaultLanguage
+}
+
+// roundTrip приводит объявленное значение к тем типам, в которые декодируется ответ mongo
+func roundTrip(v interface{}) interface{} {
+	data, err := bson.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return v
+	}
+
+	return m
+}
+
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case bson.M:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			m[k] = normalize(val)
+		}
+		return m
+	case bson.D:
+		m := make(map[string]interface{}, len(v))
+		for _, e := range v {
+			m[e.Key] = normalize(e.Value)
+		}
+		return m
+	case bson.A:
+		a := make([]interface{}, 0, len(v))
+		for _, val := range v {
+			a = append(a, normalize(val))
+		}
+		return a
+	}
+
+	return v
+}
@@ -0,0 +1,236 @@
+package links
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+func TestIndexMatches(t *testing.T) {
+	t.Parallel()
+
+	partial := mongo.IndexModel{
+		Keys: bson.D{{Key: "short_code", Value: 1}},
+		Options: options.Index().
+			SetName(shortCodeIndexName).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"short_code": bson.M{"$type": "string"}}),
+	}
+	// индекс, созданный из mongosh, хранит 1 как double
+	require.True(
+		t, indexMatches(
+			partial, indexInfo{
+				Name:                    shortCodeIndexName,
+				Key:                     bson.D{{Key: "short_code", Value: 1.0}},
+				Unique:                  true,
+				PartialFilterExpression: bson.M{"short_code": bson.M{"$type": "string"}},
+			},
+		),
+	)
+	require.False(
+		t, indexMatches(
+			partial, indexInfo{
+				Name:   shortCodeIndexName,
+				Key:    bson.D{{Key: "short_code", Value: int32(1)}},
+				Unique: true,
+			},
+		),
+	)
+	require.False(
+		t, indexMatches(
+			partial, indexInfo{
+				Name:                    shortCodeIndexName,
+				Key:                     bson.D{{Key: "short_code", Value: int32(-1)}},
+				Unique:                  true,
+				PartialFilterExpression: bson.M{"short_code": bson.M{"$type": "string"}},
+			},
+		),
+	)
+
+	compound := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}},
+		Options: options.Index().SetName(userCreatedIndexName),
+	}
+	require.True(
+		t, indexMatches(
+			compound, indexInfo{
+				Name: userCreatedIndexName,
+				Key:  bson.D{{Key: "user_id", Value: int32(1)}, {Key: "created_at", Value: int32(1)}},
+			},
+		),
+	)
+	require.False(
+		t, indexMatches(
+			compound, indexInfo{
+				Name: userCreatedIndexName,
+				Key:  bson.D{{Key: "created_at", Value: int32(1)}, {Key: "user_id", Value: int32(1)}},
+			},
+		),
+	)
+	require.False(
+		t, indexMatches(
+			compound, indexInfo{
+				Name:   userCreatedIndexName,
+				Key:    bson.D{{Key: "user_id", Value: int32(1)}, {Key: "created_at", Value: int32(1)}},
+				Unique: true,
+			},
+		),
+	)
+
+	text := mongo.IndexModel{
+		Keys:    bson.D{{Key: "title", Value: "text"}},
+		Options: options.Index().SetName(textIndexName),
+	}
+	require.True(
+		t, indexMatches(
+			text, indexInfo{
+				Name:            textIndexName,
+				Key:             bson.D{{Key: "_fts", Value: "text"}, {Key: "_ftsx", Value: int32(1)}},
+				Weights:         bson.M{"title": int32(1)},
+				DefaultLanguage: "english",
+			},
+		),
+	)
+
+	weighted := mongo.IndexModel{
+		Keys: bson.D{{Key: "title", Value: "text"}, {Key: "url", Value: "text"}},
+		Options: options.Index().
+			SetName(textIndexName).
+			SetWeights(bson.D{{Key: "title", Value: 10}}).
+			SetDefaultLanguage("none"),
+	}
+	ftsKey := bson.D{{Key: "_fts", Value: "text"}, {Key: "_ftsx", Value: int32(1)}}
+	require.True(
+		t, indexMatches(
+			weighted, indexInfo{
+				Name:            textIndexName,
+				Key:             ftsKey,
+				Weights:         bson.M{"title": int32(10), "url": int32(1)},
+				DefaultLanguage: "none",
+			},
+		),
+	)
+	require.False(
+		t, indexMatches(
+			weighted, indexInfo{
+				Name:            textIndexName,
+				Key:             ftsKey,
+				Weights:         bson.M{"title": int32(1), "url": int32(1)},
+				DefaultLanguage: "none",
+			},
+		),
+	)
+	require.False(
+		t, indexMatches(
+			weighted, indexInfo{
+				Name:            textIndexName,
+				Key:             ftsKey,
+				Weights:         bson.M{"title": int32(10), "url": int32(1)},
+				DefaultLanguage: "english",
+			},
+		),
+	)
+	require.False(
+		t, indexMatches(
+			weighted, indexInfo{
+				Name:            textIndexName,
+				Key:             ftsKey,
+				Weights:         bson.M{"title": int32(10)},
+				DefaultLanguage: "none",
+			},
+		),
+	)
+}
+
+func TestRepository_CheckIndexes(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	// отдельная база: в общей индексы меняют другие тесты
+	db := client.Database(fmt.Sprintf("links_indexes_test_%d", time.Now().UnixNano()))
+	t.Cleanup(func() { _ = db.Drop(context.Background()) })
+	repo := New(db, 5*time.Second)
+
+	drift, err := repo.CheckIndexes(ctx)
+	require.NoError(t, err)
+	require.Contains(t, drift, IndexDrift{Collection: collection, Index: idIndexName, Kind: DriftMissing})
+
+	require.NoError(t, repo.EnsureIndexes(ctx))
+
+	drift, err = repo.CheckIndexes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, drift)
+
+	// индекс с тем же именем, но другим направлением, и индекс, которого нет в объявлениях
+	_, err = db.Collection(collection).Indexes().DropOne(ctx, tagsIndexName)
+	require.NoError(t, err)
+	_, err = db.Collection(collection).Indexes().CreateMany(
+		ctx, []mongo.IndexModel{
+			{Keys: bson.D{{Key: "tags", Value: -1}}, Options: options.Index().SetName(tagsIndexName)},
+			{Keys: bson.D{{Key: "title", Value: 1}}, Options: options.Index().SetName("links_title_idx")},
+		},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.EnsureIndexes(ctx))
+
+	drift, err = repo.CheckIndexes(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, []IndexDrift{
+			{Collection: collection, Index: tagsIndexName, Kind: DriftChanged},
+			{Collection: collection, Index: "links_title_idx", Kind: DriftUnknown},
+		}, drift,
+	)
+}
+
+func TestRepository_EnsureValidator(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	db := client.Database(fmt.Sprintf("links_schema_test_%d", time.Now().UnixNano()))
+	t.Cleanup(func() { _ = db.Drop(context.Background()) })
+	repo := New(db, 5*time.Second)
+
+	params := ValidatorParams{Level: "strict", Action: "error"}
+	require.NoError(t, repo.EnsureValidator(ctx, params))
+	// повторная установка не ломается на существующей коллекции
+	require.NoError(t, repo.EnsureValidator(ctx, params))
+
+	_, err := db.Collection(collection).InsertOne(ctx, bson.M{"id": primitive.NewObjectID(), "url": 42})
+	require.Error(t, err)
+
+	id := primitive.NewObjectID()
+	_, err = repo.Create(ctx, database.CreateLinkReq{ID: id, URL: "https://ya.ru", UserID: "user"})
+	require.NoError(t, err)
+	_, err = repo.Update(ctx, database.UpdateLinkReq{ID: id, URL: "https://ya.ru/new", UserID: "user"})
+	require.NoError(t, err)
+
+	// корзина ставит и снимает deleted_at
+	require.NoError(t, repo.Delete(ctx, id))
+	_, err = repo.Restore(ctx, id)
+	require.NoError(t, err)
+	_, err = repo.DeleteByUserID(ctx, "user")
+	require.NoError(t, err)
+	_, err = repo.RestoreByUserID(ctx, "user")
+	require.NoError(t, err)
+}
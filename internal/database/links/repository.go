@@ -2,6 +2,7 @@ package links
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,7 +14,24 @@ import (
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
 )
 
-const collection = "links"
+const (
+	collection            = "links"
+	textIndexName         = "links_text_idx"
+	shortCodeIndexName    = "links_short_code_uniq_idx"
+	idIndexName           = "links_id_uniq_idx"
+	canonicalURLIndexName = "links_user_id_canonical_url_uniq_idx"
+	deletedIndexName      = "links_deleted_at_idx"
+	healthIndexName       = "links_health_checked_at_idx"
+	brokenIndexName       = "links_health_failure_streak_idx"
+
+	visitsCollection    = "visits"
+	visitsLinkIndexName = "visits_link_id_visited_at_idx"
+	visitsDayFormat     = "%Y-%m-%d"
+	visitsDayLayout     = "2006-01-02"
+)
+
+// updateFields поля, которые Update меняет без маски
+var updateFields = []string{"title", "description", "url", "images", "tags", "user_id"}
 
 func New(db *mongo.Database, timeout time.Duration) *Repository {
 	return &Repository{db: db, timeout: timeout}
@@ -31,67 +49,332 @@ func (r *Repository) Create(ctx context.Context, req database.CreateLinkReq) (da
 	now := time.Now()
 
 	l := database.Link{
-		ID:        req.ID,
-		Title:     req.Title,
-		URL:       req.URL,
-		Images:    req.Images,
-		Tags:      req.Tags,
-		UserID:    req.UserID,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:           req.ID,
+		Title:        req.Title,
+		Description:  req.Description,
+		URL:          req.URL,
+		CanonicalURL: req.CanonicalURL,
+		Images:       req.Images,
+		Tags:         req.Tags,
+		UserID:       req.UserID,
+		ShortCode:    req.ShortCode,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		Version:      1,
 	}
 	if _, err := r.db.Collection(collection).InsertOne(ctx, l); err != nil {
-		return l, fmt.Errorf("mongo InsertOne: %w", err)
+		return l, fmt.Errorf("mongo InsertOne: %w", convertError(err))
 	}
 
 	return l, nil
 }
 
+// Update меняет поля существующей ссылки, сохраняя created_at и короткий код, и увеличивает версию. Ссылки нет или
+// она в корзине: вернется ErrNotFound. Если ExpectedVersion задана и не совпала, вернется ErrVersionMismatch.
+// Создает ссылки только Create: там проверяются владелец и дубликаты url и подтягиваются метаданные
 func (r *Repository) Update(ctx context.Context, req database.UpdateLinkReq) (database.Link, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
 	now := time.Now()
 
-	l := database.Link{
-		ID:        req.ID,
-		Title:     req.Title,
-		URL:       req.URL,
-		Images:    req.Images,
-		Tags:      req.Tags,
-		UserID:    req.UserID,
-		CreatedAt: now, // обновляем и created_at также. Подумайте как поменять методы так,
-		// чтобы поле created_at не обновлялось, это можно сделать разными способоами
-		UpdatedAt: now,
+	values := map[string]interface{}{
+		"title":       req.Title,
+		"description": req.Description,
+		"url":         req.URL,
+		"images":      req.Images,
+		"tags":        req.Tags,
+		"user_id":     req.UserID,
+	}
+	fields := req.Fields
+	if len(fields) == 0 {
+		fields = updateFields
 	}
 
-	opts := options.Replace().SetUpsert(true)
+	// $set вместо замены документа, чтобы не потерять короткий код и время создания
+	set := bson.M{"updated_at": now}
+	for _, field := range fields {
+		value, ok := values[field]
+		if !ok {
+			return database.Link{}, fmt.Errorf("field %s: %w", field, database.ErrInvalid)
+		}
+		set[field] = value
+	}
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	// канонический url меняется вместе с url. Пустая строка попала бы в уникальный индекс, поэтому без канонического
+	// url поле удаляется
+	if _, ok := set["url"]; ok {
+		if req.CanonicalURL != "" {
+			set["canonical_url"] = req.CanonicalURL
+		} else {
+			update["$unset"] = bson.M{"canonical_url": ""}
+		}
+	}
 
-	if _, err := r.db.Collection(collection).ReplaceOne(ctx, bson.M{"id": req.ID}, l, opts); err != nil {
-		return l, fmt.Errorf("mongo ReplaceOne: %w", err)
+	// ссылка из корзины не меняется
+	filter := bson.M{"id": req.ID, "deleted_at": nil}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if req.ExpectedVersion != nil {
+		filter["version"] = *req.ExpectedVersion
+		if *req.ExpectedVersion == 0 {
+			// у ссылок, созданных до появления версий, поля нет
+			filter["version"] = bson.M{"$in": bson.A{0, nil}}
+		}
+	}
+
+	var l database.Link
+	result := r.db.Collection(collection).FindOneAndUpdate(ctx, filter, update, opts)
+	err := result.Decode(&l)
+	if errors.Is(err, mongo.ErrNoDocuments) && req.ExpectedVersion != nil {
+		if _, findErr := r.FindByID(ctx, req.ID); findErr == nil {
+			return l, fmt.Errorf("mongo FindOneAndUpdate: %w", database.ErrVersionMismatch)
+		}
+	}
+	if err != nil {
+		return l, fmt.Errorf("mongo FindOneAndUpdate: %w", convertError(err))
 	}
 
 	return l, nil
 }
 
+// ApplyMetadata заполняет пустые title, description и images ссылки. Условие проверяется в самом update, чтобы не
+// затереть значения, которые пользователь успел задать, пока скачивалась страница
+func (r *Repository) ApplyMetadata(ctx context.Context, id primitive.ObjectID, meta database.LinkMetadata) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	// отсутствующее поле считается пустым, поэтому сравниваем через $ifNull
+	isEmpty := func(field string, empty interface{}) bson.M {
+		return bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$" + field, empty}}, empty}}
+	}
+	fillEmpty := func(field string, empty, value interface{}) bson.M {
+		return bson.M{"$cond": bson.A{isEmpty(field, empty), value, "$" + field}}
+	}
+
+	set := bson.M{}
+	var filled bson.A
+	if meta.Title != "" {
+		set["title"] = fillEmpty("title", "", meta.Title)
+		filled = append(filled, isEmpty("title", ""))
+	}
+	if meta.Description != "" {
+		set["description"] = fillEmpty("description", "", meta.Description)
+		filled = append(filled, isEmpty("description", ""))
+	}
+	if len(meta.Images) > 0 {
+		set["images"] = fillEmpty("images", bson.A{}, meta.Images)
+		filled = append(filled, isEmpty("images", bson.A{}))
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	// версия растет, только если хоть одно поле действительно заполнено: иначе ETag клиента устарел бы зря
+	version := bson.M{"$ifNull": bson.A{"$version", 0}}
+	set["version"] = bson.M{"$cond": bson.A{bson.M{"$or": filled}, bson.M{"$add": bson.A{version, 1}}, version}}
+
+	update := mongo.Pipeline{{{Key: "$set", Value: set}}}
+
+	result, err := r.db.Collection(collection).UpdateOne(ctx, bson.M{"id": id}, update)
+	if err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", convertError(err))
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("mongo UpdateOne: %w", database.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *Repository) FindByShortCode(ctx context.Context, code string) (database.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var l database.Link
+	filter := bson.M{"short_code": code, "deleted_at": nil}
+	if err := r.db.Collection(collection).FindOne(ctx, filter).Decode(&l); err != nil {
+		return l, fmt.Errorf("mongo FindOne: %w", convertError(err))
+	}
+
+	return l, nil
+}
+
+// Delete переносит ссылку в корзину. Окончательно ее удалит PurgeDeleted после срока хранения
 func (r *Repository) Delete(ctx context.Context, id primitive.ObjectID) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	if _, err := r.db.Collection(collection).DeleteOne(ctx, bson.M{"id": id}); err != nil {
-		return fmt.Errorf("mongo DeletOne: %w", err)
+	result, err := r.db.Collection(collection).UpdateOne(
+		ctx, bson.M{"id": id, "deleted_at": nil}, bson.M{"$set": bson.M{"deleted_at": time.Now()}},
+	)
+	if err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", convertError(err))
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("mongo UpdateOne: %w", database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// DeleteByUserID переносит в корзину все ссылки пользователя и возвращает их количество. Повторный вызов ничего
+// не меняет, поэтому его можно безопасно повторять при доставке события об удалении пользователя. Ссылки помечаются
+// deleted_with_user, чтобы RestoreByUserID вернул только их
+func (r *Repository) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	result, err := r.db.Collection(collection).UpdateMany(
+		ctx,
+		bson.M{"user_id": userID, "deleted_at": nil},
+		bson.M{"$set": bson.M{"deleted_at": time.Now(), "deleted_with_user": true}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", convertError(err))
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// RestoreByUserID достает из корзины ссылки, перенесенные туда DeleteByUserID, и возвращает их количество. Ссылки,
+// которые пользователь удалил сам, остаются в корзине, как и ссылки, чей url уже сохранен у него заново. Повторный
+// вызов ничего не меняет
+func (r *Repository) RestoreByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	coll := r.db.Collection(collection)
+	filter := bson.M{"user_id": userID, "deleted_with_user": true}
+	restore := bson.M{"$unset": bson.M{"deleted_at": "", "deleted_with_user": ""}}
+
+	before, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("mongo CountDocuments: %w", err)
+	}
+
+	_, err = coll.UpdateMany(ctx, filter, restore)
+	if err != nil && !errors.Is(convertError(err), database.ErrDuplicateURL) {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", convertError(err))
+	}
+	if err != nil {
+		// UpdateMany останавливается на первом дубликате, остальные ссылки возвращаются по одной
+		if err := r.restoreEach(ctx, filter, restore); err != nil {
+			return 0, err
+		}
+	}
+
+	after, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("mongo CountDocuments: %w", err)
+	}
+
+	return before - after, nil
+}
+
+// restoreEach возвращает из корзины ссылки по filter, пропуская дубликаты живых ссылок
+func (r *Repository) restoreEach(ctx context.Context, filter, restore bson.M) error {
+	cursor, err := r.db.Collection(collection).Find(ctx, filter, options.Find().SetProjection(bson.M{"id": 1}))
+	if err != nil {
+		return fmt.Errorf("mongo Find: %w", err)
+	}
+
+	var ids []struct {
+		ID primitive.ObjectID `bson:"id"`
+	}
+	if err := cursor.All(ctx, &ids); err != nil {
+		return fmt.Errorf("mongo cursor All: %w", err)
+	}
+
+	for _, l := range ids {
+		_, err := r.db.Collection(collection).UpdateOne(ctx, bson.M{"id": l.ID, "deleted_with_user": true}, restore)
+		if err != nil && !errors.Is(convertError(err), database.ErrDuplicateURL) {
+			return fmt.Errorf("mongo UpdateOne: %w", convertError(err))
+		}
 	}
 
 	return nil
 }
 
+// Restore достает ссылку из корзины. Если пользователь успел заново сохранить тот же url, вернется ErrDuplicateURL
+func (r *Repository) Restore(ctx context.Context, id primitive.ObjectID) (database.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var l database.Link
+	result := r.db.Collection(collection).FindOneAndUpdate(
+		ctx,
+		bson.M{"id": id, "deleted_at": bson.M{"$ne": nil}},
+		bson.M{"$unset": bson.M{"deleted_at": "", "deleted_with_user": ""}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+	if err := result.Decode(&l); err != nil {
+		return l, fmt.Errorf("mongo FindOneAndUpdate: %w", convertError(err))
+	}
+
+	return l, nil
+}
+
+// FindDeletedByID ищет ссылку только в корзине
+func (r *Repository) FindDeletedByID(ctx context.Context, id primitive.ObjectID) (database.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var l database.Link
+	filter := bson.M{"id": id, "deleted_at": bson.M{"$ne": nil}}
+	if err := r.db.Collection(collection).FindOne(ctx, filter).Decode(&l); err != nil {
+		return l, fmt.Errorf("mongo FindOne: %w", convertError(err))
+	}
+
+	return l, nil
+}
+
+// PurgeDeleted окончательно удаляет ссылки, которые лежат в корзине с deletedBefore, вместе с их переходами
+func (r *Repository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	cursor, err := r.db.Collection(collection).Find(
+		ctx,
+		bson.M{"deleted_at": bson.M{"$lt": deletedBefore}},
+		options.Find().SetProjection(bson.M{"id": 1}),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("mongo Find: %w", err)
+	}
+
+	var purged []database.Link
+	if err := cursor.All(ctx, &purged); err != nil {
+		return 0, fmt.Errorf("mongo Decode: %w", err)
+	}
+	if len(purged) == 0 {
+		return 0, nil
+	}
+
+	ids := make(bson.A, 0, len(purged))
+	for _, l := range purged {
+		ids = append(ids, l.ID)
+	}
+
+	// сначала переходы: если удаление прервется, ссылки останутся в корзине и удалятся при следующем запуске
+	if _, err := r.db.Collection(visitsCollection).DeleteMany(ctx, bson.M{"link_id": bson.M{"$in": ids}}); err != nil {
+		return 0, fmt.Errorf("mongo DeleteMany visits: %w", err)
+	}
+
+	result, err := r.db.Collection(collection).DeleteMany(ctx, bson.M{"id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, fmt.Errorf("mongo DeleteMany: %w", err)
+	}
+
+	return result.DeletedCount, nil
+}
+
 func (r *Repository) FindByID(ctx context.Context, id primitive.ObjectID) (database.Link, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 	var l database.Link
-	result := r.db.Collection(collection).FindOne(ctx, bson.M{"id": id})
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"id": id, "deleted_at": nil})
 	if err := result.Err(); err != nil {
-		return l, fmt.Errorf("mongo FindOne: %w", err)
+		return l, fmt.Errorf("mongo FindOne: %w", convertError(err))
 	}
 
 	if err := result.Decode(&l); err != nil {
@@ -101,33 +384,87 @@ func (r *Repository) FindByID(ctx context.Context, id primitive.ObjectID) (datab
 	return l, nil
 }
 
-func (r *Repository) FindByUserID(ctx context.Context, userID string) ([]database.Link, error) {
+func (r *Repository) FindByUserID(ctx context.Context, userID string, page database.PageReq) ([]database.Link, string, error) {
+	return r.FindPageByCriteria(ctx, database.FindLinkCriteria{UserID: &userID}, page)
+}
+
+// SummaryByUserID считает ссылки пользователя вне корзины, отдает recent самых новых и topTags самых частых тегов,
+// при равном числе ссылок по имени тега. Все считается одной агрегацией, без чтения ссылок в сервис
+func (r *Repository) SummaryByUserID(
+	ctx context.Context,
+	userID string,
+	recent, topTags int,
+) (database.LinksSummary, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	var links []database.Link
-	cursor, err := r.db.Collection(collection).Find(ctx, bson.M{"user_id": userID})
+	var summary database.LinksSummary
+
+	// $limit: 0 mongo не принимает, поэтому ненужные части не запрашиваются вовсе
+	facet := bson.M{"count": bson.A{bson.M{"$count": "count"}}}
+	if recent > 0 {
+		facet["recent"] = bson.A{
+			bson.M{"$sort": bson.D{{Key: "created_at", Value: -1}, {Key: "id", Value: -1}}},
+			bson.M{"$limit": recent},
+		}
+	}
+	if topTags > 0 {
+		facet["tags"] = bson.A{
+			bson.M{"$unwind": "$tags"},
+			bson.M{"$group": bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+			bson.M{"$limit": topTags},
+		}
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "deleted_at": nil}}},
+		{{Key: "$facet", Value: facet}},
+	}
+
+	cursor, err := r.db.Collection(collection).Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, fmt.Errorf("mongo Find: %w", err)
+		return summary, fmt.Errorf("mongo Aggregate: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var result []struct {
+		Count []struct {
+			Count int64 `bson:"count"`
+		} `bson:"count"`
+		Recent []database.Link `bson:"recent"`
+		Tags   []struct {
+			Tag   string `bson:"_id"`
+			Count int64  `bson:"count"`
+		} `bson:"tags"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return summary, fmt.Errorf("mongo Decode: %w", err)
+	}
+	if len(result) == 0 {
+		return summary, nil
 	}
 
-	for cursor.Next(ctx) {
-		var l database.Link
-		if err := cursor.Decode(&l); err != nil {
-			return nil, fmt.Errorf("mongo Decode: %w", err)
-		}
-		links = append(links, l)
+	if len(result[0].Count) > 0 {
+		summary.Count = result[0].Count[0].Count
 	}
-	return links, nil
+	summary.Recent = result[0].Recent
+	for _, t := range result[0].Tags {
+		summary.TopTags = append(summary.TopTags, database.TagCount{Tag: t.Tag, Count: t.Count})
+	}
+
+	return summary, nil
 }
 
-func (r *Repository) FindByUserAndURL(ctx context.Context, link, userID string) (database.Link, error) {
+// FindByUserAndURL ищет ссылку пользователя по каноническому url, полученному из urlnorm.Normalize
+func (r *Repository) FindByUserAndURL(ctx context.Context, canonicalURL, userID string) (database.Link, error) {
 	var l database.Link
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
-	result := r.db.Collection(collection).FindOne(ctx, bson.M{"url": link, "user_id": userID})
+	result := r.db.Collection(collection).FindOne(
+		ctx, bson.M{"canonical_url": canonicalURL, "user_id": userID, "deleted_at": nil},
+	)
 	if err := result.Err(); err != nil {
-		return l, fmt.Errorf("mongo FindOne: %w", err)
+		return l, fmt.Errorf("mongo FindOne: %w", convertError(err))
 	}
 
 	if err := result.Decode(&l); err != nil {
@@ -137,8 +474,57 @@ func (r *Repository) FindByUserAndURL(ctx context.Context, link, userID string)
 	return l, nil
 }
 
-func (r *Repository) FindAll(ctx context.Context) ([]database.Link, error) {
-	return r.FindByCriteria(ctx, database.FindLinkCriteria{})
+// FindAll возвращает страницу ссылок в порядке (created_at, id) и курсор следующей страницы. Пустой курсор
+// означает, что страница последняя
+func (r *Repository) FindAll(ctx context.Context, page database.PageReq) ([]database.Link, string, error) {
+	return r.FindPageByCriteria(ctx, database.FindLinkCriteria{}, page)
+}
+
+// FindPageByCriteria возвращает страницу ссылок по критериям и курсор следующей страницы. Limit и After из
+// критериев заменяются значениями из page
+func (r *Repository) FindPageByCriteria(
+	ctx context.Context,
+	criteria database.FindLinkCriteria,
+	page database.PageReq,
+) ([]database.Link, string, error) {
+	var c database.Cursor
+	if page.Cursor != "" {
+		var err error
+		if c, err = database.DecodeCursor(page.Cursor); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// у оценки релевантности нет стабильного уникального ключа, поэтому такие выборки листаем смещением
+	byOffset := criteria.Sort == database.SortRelevance
+	if byOffset {
+		criteria.Offset = &c.Offset
+	} else if page.Cursor != "" {
+		criteria.After = &c
+	}
+
+	// запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	limit := int64(page.Limit())
+	fetch := limit + 1
+	criteria.Limit = &fetch
+
+	links, err := r.FindByCriteria(ctx, criteria)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if int64(len(links)) > limit {
+		links = links[:limit]
+		last := links[limit-1]
+		if byOffset {
+			next = database.Cursor{Offset: c.Offset + limit}.Encode()
+		} else {
+			next = database.Cursor{CreatedAt: last.CreatedAt, ID: last.ID.Hex()}.Encode()
+		}
+	}
+
+	return links, next, nil
 }
 
 func (r *Repository) FindByCriteria(ctx context.Context, criteria database.FindLinkCriteria) ([]database.Link, error) {
@@ -147,8 +533,24 @@ func (r *Repository) FindByCriteria(ctx context.Context, criteria database.FindL
 
 	var links []database.Link
 
-	filter := bson.M{}
-	opts := options.Find()
+	filter := bson.M{"deleted_at": nil}
+	if criteria.Deleted {
+		filter["deleted_at"] = bson.M{"$ne": nil}
+	}
+	dir, after := 1, "$gt"
+	if criteria.Sort == database.SortCreatedDesc {
+		dir, after = -1, "$lt"
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: dir}, {Key: "id", Value: dir}})
+	if criteria.Query != "" {
+		filter["$text"] = bson.M{"$search": criteria.Query}
+		if criteria.Sort == database.SortRelevance {
+			score := bson.M{"$meta": "textScore"}
+			opts.SetProjection(bson.M{"score": score})
+			opts.SetSort(bson.D{{Key: "score", Value: score}, {Key: "id", Value: 1}})
+		}
+	}
 	if criteria.Limit != nil {
 		opts.SetLimit(*criteria.Limit)
 	}
@@ -164,13 +566,43 @@ func (r *Repository) FindByCriteria(ctx context.Context, criteria database.FindL
 			tagsCriteria = append(tagsCriteria, tag)
 		}
 
-		filter["tags"] = bson.M{"$in": tagsCriteria}
+		if criteria.TagsMatchAll {
+			filter["tags"] = bson.M{"$all": tagsCriteria}
+		} else {
+			filter["tags"] = bson.M{"$in": tagsCriteria}
+		}
+	}
+	if criteria.CreatedAfter != nil || criteria.CreatedBefore != nil {
+		createdAt := bson.M{}
+		if criteria.CreatedAfter != nil {
+			createdAt["$gte"] = *criteria.CreatedAfter
+		}
+		if criteria.CreatedBefore != nil {
+			createdAt["$lt"] = *criteria.CreatedBefore
+		}
+
+		filter["created_at"] = createdAt
+	}
+	if criteria.MinFailures > 0 {
+		filter["health.failure_streak"] = bson.M{"$gte": criteria.MinFailures}
+	}
+	if criteria.After != nil {
+		id, err := primitive.ObjectIDFromHex(criteria.After.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed cursor", database.ErrInvalid)
+		}
+
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{after: criteria.After.CreatedAt}},
+			bson.M{"created_at": criteria.After.CreatedAt, "id": bson.M{after: id}},
+		}
 	}
 
 	cursor, err := r.db.Collection(collection).Find(ctx, filter, opts)
 	if err != nil {
 		return nil, fmt.Errorf("mongo Find: %w", err)
 	}
+	defer cursor.Close(ctx)
 
 	for cursor.Next(ctx) {
 		var l database.Link
@@ -182,3 +614,164 @@ func (r *Repository) FindByCriteria(ctx context.Context, criteria database.FindL
 
 	return links, nil
 }
+
+// FindDueForCheck возвращает до limit ссылок, которые не проверялись с checkedBefore, начиная с непроверенных
+func (r *Repository) FindDueForCheck(
+	ctx context.Context,
+	checkedBefore time.Time,
+	limit int64,
+) ([]database.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{
+		"deleted_at": nil,
+		"$or": bson.A{
+			bson.M{"health.checked_at": bson.M{"$exists": false}},
+			bson.M{"health.checked_at": bson.M{"$lt": checkedBefore}},
+		},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "health.checked_at", Value: 1}}).
+		SetLimit(limit).
+		SetProjection(bson.M{"id": 1, "url": 1, "health": 1})
+
+	cursor, err := r.db.Collection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo Find: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var links []database.Link
+	if err := cursor.All(ctx, &links); err != nil {
+		return nil, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return links, nil
+}
+
+// UpdateHealth сохраняет результат проверки. Неудачная проверка увеличивает счетчик неудач подряд, удачная
+// сбрасывает его. updated_at не меняется: проверка не правка ссылки
+func (r *Repository) UpdateHealth(ctx context.Context, id primitive.ObjectID, check database.HealthCheck) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	set := bson.M{
+		"health.status_code":  check.StatusCode,
+		"health.error":        check.Error,
+		"health.redirect_url": check.RedirectURL,
+		"health.checked_at":   check.CheckedAt,
+	}
+	update := bson.M{"$set": set}
+	if check.OK {
+		set["health.failure_streak"] = 0
+	} else {
+		update["$inc"] = bson.M{"health.failure_streak": 1}
+	}
+
+	result, err := r.db.Collection(collection).UpdateOne(ctx, bson.M{"id": id}, update)
+	if err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", convertError(err))
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("mongo UpdateOne: %w", database.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *Repository) InsertVisits(ctx context.Context, visits []database.Visit) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	docs := make([]interface{}, 0, len(visits))
+	for _, v := range visits {
+		docs = append(docs, v)
+	}
+
+	// неупорядоченная вставка: одна плохая запись не должна терять остальные записи пачки
+	if _, err := r.db.Collection(visitsCollection).InsertMany(
+		ctx, docs, options.InsertMany().SetOrdered(false),
+	); err != nil {
+		return fmt.Errorf("mongo InsertMany: %w", convertError(err))
+	}
+
+	return nil
+}
+
+// VisitStats считает переходы по ссылке: всего, уникальных посетителей и по дням в UTC
+func (r *Repository) VisitStats(ctx context.Context, criteria database.FindVisitsCriteria) (database.LinkStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var stats database.LinkStats
+
+	match := bson.M{"link_id": criteria.LinkID}
+	if criteria.VisitedAfter != nil || criteria.VisitedBefore != nil {
+		visitedAt := bson.M{}
+		if criteria.VisitedAfter != nil {
+			visitedAt["$gte"] = *criteria.VisitedAfter
+		}
+		if criteria.VisitedBefore != nil {
+			visitedAt["$lt"] = *criteria.VisitedBefore
+		}
+
+		match["visited_at"] = visitedAt
+	}
+
+	// сначала группируем по хешу ip, а уже потом считаем: $addToSet по всем переходам популярной ссылки
+	// может не уместиться в документ
+	day := bson.M{"$dateToString": bson.M{"format": visitsDayFormat, "date": "$visited_at", "timezone": "UTC"}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$facet", Value: bson.M{
+			"totals": bson.A{
+				bson.M{"$group": bson.M{"_id": "$ip_hash", "visits": bson.M{"$sum": 1}}},
+				bson.M{"$group": bson.M{"_id": nil, "visits": bson.M{"$sum": "$visits"}, "unique": bson.M{"$sum": 1}}},
+			},
+			"daily": bson.A{
+				bson.M{"$group": bson.M{"_id": bson.M{"day": day, "ip": "$ip_hash"}, "visits": bson.M{"$sum": 1}}},
+				bson.M{"$group": bson.M{"_id": "$_id.day", "visits": bson.M{"$sum": "$visits"}, "unique": bson.M{"$sum": 1}}},
+				bson.M{"$sort": bson.M{"_id": 1}},
+			},
+		}}},
+	}
+
+	cursor, err := r.db.Collection(visitsCollection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return stats, fmt.Errorf("mongo Aggregate: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var result []struct {
+		Totals []struct {
+			Visits int64 `bson:"visits"`
+			Unique int64 `bson:"unique"`
+		} `bson:"totals"`
+		Daily []struct {
+			Day    string `bson:"_id"`
+			Visits int64  `bson:"visits"`
+			Unique int64  `bson:"unique"`
+		} `bson:"daily"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return stats, fmt.Errorf("mongo Decode: %w", err)
+	}
+	if len(result) == 0 {
+		return stats, nil
+	}
+
+	if len(result[0].Totals) > 0 {
+		stats.Total, stats.UniqueVisitors = result[0].Totals[0].Visits, result[0].Totals[0].Unique
+	}
+	for _, d := range result[0].Daily {
+		t, err := time.Parse(visitsDayLayout, d.Day)
+		if err != nil {
+			return stats, fmt.Errorf("parse visits day: %w", err)
+		}
+
+		stats.Daily = append(stats.Daily, database.DailyVisits{Day: t, Visits: d.Visits, UniqueVisitors: d.Unique})
+	}
+
+	return stats, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"sync"
 	"testing"
@@ -51,7 +52,7 @@ func TestMain(m *testing.M) {
 				},
 			)
 			if err != nil {
-				log.Fatalf("mongo.Connect: %w", err)
+				log.Fatalf("mongo.Connect: %v", err)
 			}
 
 			client = linksDBConn
@@ -136,6 +137,96 @@ func TestRepository_Update(t *testing.T) {
 	}
 }
 
+func TestRepository_UpdateVersion(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	id := primitive.NewObjectID()
+	userID := uuid.New().String()
+	created, err := linksRepo.Create(ctx, database.CreateLinkReq{ID: id, URL: "https://ya.ru", UserID: userID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), created.Version)
+
+	version := created.Version
+	updated, err := linksRepo.Update(
+		ctx, database.UpdateLinkReq{ID: id, URL: "https://ya.ru/1", UserID: userID, ExpectedVersion: &version},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.Version)
+	require.WithinDuration(t, created.CreatedAt, updated.CreatedAt, time.Millisecond)
+
+	// запись по устаревшей версии не проходит и ничего не меняет
+	_, err = linksRepo.Update(
+		ctx, database.UpdateLinkReq{ID: id, URL: "https://ya.ru/2", UserID: userID, ExpectedVersion: &version},
+	)
+	require.ErrorIs(t, err, database.ErrVersionMismatch)
+
+	found, err := linksRepo.FindByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "https://ya.ru/1", found.URL)
+	require.Equal(t, int64(2), found.Version)
+
+	// с ожидаемой версией ссылка не создается заново
+	_, err = linksRepo.Update(
+		ctx, database.UpdateLinkReq{
+			ID: primitive.NewObjectID(), URL: "https://ya.ru", UserID: userID, ExpectedVersion: &version,
+		},
+	)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func TestRepository_UpdateFields(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	id := primitive.NewObjectID()
+	userID := uuid.New().String()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:           id,
+			URL:          "https://ya.ru",
+			CanonicalURL: "https://ya.ru",
+			Title:        "ya",
+			Tags:         []string{"search"},
+			UserID:       userID,
+		},
+	)
+	require.NoError(t, err)
+
+	updated, err := linksRepo.Update(
+		ctx, database.UpdateLinkReq{ID: id, Tags: []string{"news"}, Fields: []string{"tags"}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"news"}, updated.Tags)
+	require.Equal(t, "ya", updated.Title)
+	require.Equal(t, "https://ya.ru", updated.URL)
+	require.Equal(t, "https://ya.ru", updated.CanonicalURL)
+	require.Equal(t, userID, updated.UserID)
+
+	_, err = linksRepo.Update(ctx, database.UpdateLinkReq{ID: id, Fields: []string{"short_code"}})
+	require.ErrorIs(t, err, database.ErrInvalid)
+
+	// ни частичное, ни полное обновление не создает ссылку
+	_, err = linksRepo.Update(
+		ctx, database.UpdateLinkReq{ID: primitive.NewObjectID(), Title: "new", Fields: []string{"title"}},
+	)
+	require.ErrorIs(t, err, database.ErrNotFound)
+	_, err = linksRepo.Update(
+		ctx, database.UpdateLinkReq{ID: primitive.NewObjectID(), URL: "https://ya.ru", UserID: userID},
+	)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
 func TestRepository_FindByUserID(t *testing.T) {
 	t.Parallel()
 
@@ -172,12 +263,339 @@ func TestRepository_FindByUserID(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	list, err := linksRepo.FindByUserID(ctx, userID)
+	list, _, err := linksRepo.FindByUserID(ctx, userID, database.PageReq{})
 	require.NoError(t, err)
 
 	if len(list) < 2 {
 		require.GreaterOrEqual(t, len(list), 2)
 	}
+
+	first, next, err := linksRepo.FindByUserID(ctx, userID, database.PageReq{Size: 1})
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+	require.NotEmpty(t, next)
+
+	second, next, err := linksRepo.FindByUserID(ctx, userID, database.PageReq{Size: 1, Cursor: next})
+	require.NoError(t, err)
+	require.Len(t, second, 1)
+	require.Empty(t, next)
+	require.NotEqual(t, first[0].ID, second[0].ID)
+}
+
+func TestRepository_FindPageByCriteria(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	userID := uuid.New().String()
+	for _, tags := range [][]string{{"go", "db"}, {"go"}, {"db"}} {
+		_, err := linksRepo.Create(
+			ctx, database.CreateLinkReq{
+				ID:     primitive.NewObjectID(),
+				URL:    "https://ya.ru",
+				Tags:   tags,
+				UserID: userID,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	anyTag, _, err := linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{UserID: &userID, Tags: []string{"go", "db"}}, database.PageReq{},
+	)
+	require.NoError(t, err)
+	require.Len(t, anyTag, 3)
+
+	allTags, _, err := linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{UserID: &userID, Tags: []string{"go", "db"}, TagsMatchAll: true},
+		database.PageReq{},
+	)
+	require.NoError(t, err)
+	require.Len(t, allTags, 1)
+
+	desc, _, err := linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{UserID: &userID, Sort: database.SortCreatedDesc}, database.PageReq{},
+	)
+	require.NoError(t, err)
+	require.Len(t, desc, 3)
+	require.Equal(t, anyTag[0].ID, desc[2].ID)
+
+	future := time.Now().Add(time.Hour)
+	none, _, err := linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{UserID: &userID, CreatedAfter: &future}, database.PageReq{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func TestRepository_TextSearch(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	require.NoError(t, linksRepo.EnsureIndexes(ctx))
+
+	userID := uuid.New().String()
+	word := "w" + primitive.NewObjectID().Hex()
+	for _, title := range []string{word + " " + word, word, "other"} {
+		_, err := linksRepo.Create(
+			ctx, database.CreateLinkReq{
+				ID:     primitive.NewObjectID(),
+				URL:    "https://ya.ru",
+				Title:  title,
+				UserID: userID,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	list, next, err := linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{Query: word, UserID: &userID, Sort: database.SortRelevance},
+		database.PageReq{Size: 1},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.NotEmpty(t, next)
+	require.Equal(t, word+" "+word, list[0].Title)
+	require.Greater(t, list[0].Score, 0.0)
+
+	list, next, err = linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{Query: word, UserID: &userID, Sort: database.SortRelevance},
+		database.PageReq{Size: 1, Cursor: next},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Empty(t, next)
+	require.Equal(t, word, list[0].Title)
+}
+
+func TestRepository_ShortCode(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	require.NoError(t, linksRepo.EnsureIndexes(ctx))
+
+	id := primitive.NewObjectID()
+	code := id.Hex()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:        id,
+			URL:       "https://ya.ru",
+			UserID:    uuid.New().String(),
+			ShortCode: code,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:        primitive.NewObjectID(),
+			URL:       "https://google.ru",
+			UserID:    uuid.New().String(),
+			ShortCode: code,
+		},
+	)
+	require.True(t, errors.Is(err, database.ErrShortCodeTaken))
+
+	updated, err := linksRepo.Update(ctx, database.UpdateLinkReq{ID: id, URL: "https://ya.ru/new"})
+	require.NoError(t, err)
+	require.Equal(t, code, updated.ShortCode)
+
+	found, err := linksRepo.FindByShortCode(ctx, code)
+	require.NoError(t, err)
+	require.Equal(t, id, found.ID)
+	require.Equal(t, "https://ya.ru/new", found.URL)
+}
+
+func TestRepository_CanonicalURL(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	require.NoError(t, linksRepo.EnsureIndexes(ctx))
+
+	id := primitive.NewObjectID()
+	userID := uuid.New().String()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{ID: id, URL: "https://Ya.ru", CanonicalURL: "https://ya.ru/", UserID: userID},
+	)
+	require.NoError(t, err)
+
+	_, err = linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:           primitive.NewObjectID(),
+			URL:          "https://ya.ru/#top",
+			CanonicalURL: "https://ya.ru/",
+			UserID:       userID,
+		},
+	)
+	require.True(t, errors.Is(err, database.ErrDuplicateURL))
+
+	// ссылки без канонического url в уникальный индекс не попадают
+	for i := 0; i < 2; i++ {
+		_, err = linksRepo.Create(
+			ctx, database.CreateLinkReq{ID: primitive.NewObjectID(), URL: "https://ya.ru", UserID: userID},
+		)
+		require.NoError(t, err)
+	}
+
+	found, err := linksRepo.FindByUserAndURL(ctx, "https://ya.ru/", userID)
+	require.NoError(t, err)
+	require.Equal(t, id, found.ID)
+
+	_, err = linksRepo.FindByUserAndURL(ctx, "https://ya.ru/", uuid.New().String())
+	require.True(t, errors.Is(err, database.ErrNotFound))
+}
+
+func TestRepository_ApplyMetadata(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	id := primitive.NewObjectID()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:     id,
+			URL:    "https://ya.ru",
+			Title:  "мой заголовок",
+			UserID: uuid.New().String(),
+		},
+	)
+	require.NoError(t, err)
+
+	require.NoError(
+		t, linksRepo.ApplyMetadata(
+			ctx, id, database.LinkMetadata{
+				Title:       "Яндекс",
+				Description: "Поиск",
+				Images:      []string{"https://ya.ru/logo.png"},
+			},
+		),
+	)
+
+	l, err := linksRepo.FindByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "мой заголовок", l.Title)
+	require.Equal(t, "Поиск", l.Description)
+	require.Equal(t, []string{"https://ya.ru/logo.png"}, l.Images)
+
+	err = linksRepo.ApplyMetadata(ctx, primitive.NewObjectID(), database.LinkMetadata{Title: "Яндекс"})
+	require.True(t, errors.Is(err, database.ErrNotFound))
+}
+
+func TestRepository_Health(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	require.NoError(t, linksRepo.EnsureIndexes(ctx))
+
+	id := primitive.NewObjectID()
+	userID := uuid.New().String()
+	_, err := linksRepo.Create(ctx, database.CreateLinkReq{ID: id, URL: "https://ya.ru", UserID: userID})
+	require.NoError(t, err)
+
+	due, err := linksRepo.FindDueForCheck(ctx, time.Now(), 1)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Nil(t, due[0].Health)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(
+			t, linksRepo.UpdateHealth(
+				ctx, id, database.HealthCheck{StatusCode: http.StatusNotFound, CheckedAt: time.Now()},
+			),
+		)
+	}
+
+	broken, _, err := linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{UserID: &userID, MinFailures: 2}, database.PageReq{},
+	)
+	require.NoError(t, err)
+	require.Len(t, broken, 1)
+	require.Equal(t, http.StatusNotFound, broken[0].Health.StatusCode)
+	require.Equal(t, 2, broken[0].Health.FailureStreak)
+
+	require.NoError(
+		t, linksRepo.UpdateHealth(
+			ctx, id, database.HealthCheck{OK: true, StatusCode: http.StatusOK, CheckedAt: time.Now()},
+		),
+	)
+
+	broken, _, err = linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{UserID: &userID, MinFailures: 1}, database.PageReq{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, broken)
+
+	err = linksRepo.UpdateHealth(ctx, primitive.NewObjectID(), database.HealthCheck{CheckedAt: time.Now()})
+	require.True(t, errors.Is(err, database.ErrNotFound))
+}
+
+func TestRepository_VisitStats(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	linkID := primitive.NewObjectID()
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(
+		t, linksRepo.InsertVisits(
+			ctx, []database.Visit{
+				{LinkID: linkID, VisitedAt: day.Add(time.Hour), IPHash: "a"},
+				{LinkID: linkID, VisitedAt: day.Add(2 * time.Hour), IPHash: "a"},
+				{LinkID: linkID, VisitedAt: day.Add(3 * time.Hour), IPHash: "b"},
+				{LinkID: linkID, VisitedAt: day.Add(25 * time.Hour), IPHash: "a"},
+				{LinkID: primitive.NewObjectID(), VisitedAt: day, IPHash: "c"},
+			},
+		),
+	)
+
+	stats, err := linksRepo.VisitStats(ctx, database.FindVisitsCriteria{LinkID: linkID})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), stats.Total)
+	require.Equal(t, int64(2), stats.UniqueVisitors)
+	require.Equal(
+		t, []database.DailyVisits{
+			{Day: day, Visits: 3, UniqueVisitors: 2},
+			{Day: day.AddDate(0, 0, 1), Visits: 1, UniqueVisitors: 1},
+		}, stats.Daily,
+	)
+
+	after := day.AddDate(0, 0, 1)
+	stats, err = linksRepo.VisitStats(ctx, database.FindVisitsCriteria{LinkID: linkID, VisitedAfter: &after})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.Total)
+	require.Len(t, stats.Daily, 1)
+
+	stats, err = linksRepo.VisitStats(ctx, database.FindVisitsCriteria{LinkID: primitive.NewObjectID()})
+	require.NoError(t, err)
+	require.Zero(t, stats.Total)
+	require.Empty(t, stats.Daily)
 }
 
 func TestRepository_Delete(t *testing.T) {
@@ -207,9 +625,227 @@ func TestRepository_Delete(t *testing.T) {
 	require.NoError(t, err)
 
 	_, err = linksRepo.FindByID(ctx, id)
-	if err != nil {
-		if !errors.Is(err, mongo.ErrNoDocuments) {
-			t.Fatal(err)
+	require.True(t, errors.Is(err, database.ErrNotFound))
+
+	err = linksRepo.Delete(ctx, id)
+	require.True(t, errors.Is(err, database.ErrNotFound))
+}
+
+func TestRepository_Restore(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	require.NoError(t, linksRepo.EnsureIndexes(ctx))
+
+	id := primitive.NewObjectID()
+	userID := uuid.New().String()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{ID: id, URL: "https://ya.ru", CanonicalURL: "https://ya.ru/", UserID: userID},
+	)
+	require.NoError(t, err)
+
+	_, err = linksRepo.Restore(ctx, id)
+	require.True(t, errors.Is(err, database.ErrNotFound))
+
+	require.NoError(t, linksRepo.Delete(ctx, id))
+
+	trashed, err := linksRepo.FindDeletedByID(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, trashed.DeletedAt)
+
+	trash, _, err := linksRepo.FindPageByCriteria(
+		ctx, database.FindLinkCriteria{UserID: &userID, Deleted: true}, database.PageReq{},
+	)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+
+	live, _, err := linksRepo.FindByUserID(ctx, userID, database.PageReq{})
+	require.NoError(t, err)
+	require.Empty(t, live)
+
+	// ссылка в корзине не мешает сохранить тот же url, но тогда восстановить ее уже нельзя
+	againID := primitive.NewObjectID()
+	_, err = linksRepo.Create(
+		ctx, database.CreateLinkReq{ID: againID, URL: "https://ya.ru/", CanonicalURL: "https://ya.ru/", UserID: userID},
+	)
+	require.NoError(t, err)
+
+	_, err = linksRepo.Restore(ctx, id)
+	require.True(t, errors.Is(err, database.ErrDuplicateURL))
+
+	require.NoError(t, linksRepo.Delete(ctx, againID))
+
+	restored, err := linksRepo.Restore(ctx, id)
+	require.NoError(t, err)
+	require.Nil(t, restored.DeletedAt)
+
+	_, err = linksRepo.FindByID(ctx, id)
+	require.NoError(t, err)
+
+	// правка не достает ссылку из корзины
+	_, err = linksRepo.Update(ctx, database.UpdateLinkReq{ID: againID, URL: "https://ya.ru/new", UserID: userID})
+	require.True(t, errors.Is(err, database.ErrNotFound))
+}
+
+// TestRepository_PurgeDeleted не параллельный: очистка затронула бы корзину других тестов
+func TestRepository_PurgeDeleted(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	id := primitive.NewObjectID()
+	_, err := linksRepo.Create(ctx, database.CreateLinkReq{ID: id, URL: "https://ya.ru", UserID: uuid.New().String()})
+	require.NoError(t, err)
+	require.NoError(t, linksRepo.InsertVisits(ctx, []database.Visit{{LinkID: id, VisitedAt: time.Now()}}))
+	require.NoError(t, linksRepo.Delete(ctx, id))
+
+	// срок хранения еще не вышел
+	_, err = linksRepo.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = linksRepo.FindDeletedByID(ctx, id)
+	require.NoError(t, err)
+
+	purged, err := linksRepo.PurgeDeleted(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, purged, int64(1))
+
+	_, err = linksRepo.FindDeletedByID(ctx, id)
+	require.True(t, errors.Is(err, database.ErrNotFound))
+
+	stats, err := linksRepo.VisitStats(ctx, database.FindVisitsCriteria{LinkID: id})
+	require.NoError(t, err)
+	require.Zero(t, stats.Total)
+}
+
+func TestRepository_DeleteByUserID(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+	for i := 0; i < 3; i++ {
+		_, err := linksRepo.Create(
+			ctx, database.CreateLinkReq{ID: primitive.NewObjectID(), URL: "https://ya.ru", UserID: userID},
+		)
+		require.NoError(t, err)
+	}
+
+	deleted, err := linksRepo.DeleteByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), deleted)
+
+	live, _, err := linksRepo.FindByUserID(ctx, userID, database.PageReq{})
+	require.NoError(t, err)
+	require.Empty(t, live)
+
+	// повторная доставка события ничего не меняет
+	deleted, err = linksRepo.DeleteByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+}
+
+func TestRepository_SummaryByUserID(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+	ids := make([]primitive.ObjectID, 5)
+	for i := range ids {
+		ids[i] = primitive.NewObjectID()
+		tags := []string{"all"}
+		if i%2 == 0 {
+			tags = append(tags, "even")
 		}
+		_, err := linksRepo.Create(
+			ctx, database.CreateLinkReq{ID: ids[i], URL: "https://ya.ru", Tags: tags, UserID: userID},
+		)
+		require.NoError(t, err)
+	}
+	// ссылки из корзины в сводку не попадают
+	require.NoError(t, linksRepo.Delete(ctx, ids[4]))
+
+	summary, err := linksRepo.SummaryByUserID(ctx, userID, 2, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), summary.Count)
+	require.Len(t, summary.Recent, 2)
+	require.Equal(t, ids[3], summary.Recent[0].ID)
+	require.Equal(t, ids[2], summary.Recent[1].ID)
+	require.Equal(t, []database.TagCount{{Tag: "all", Count: 4}, {Tag: "even", Count: 2}}, summary.TopTags)
+
+	summary, err = linksRepo.SummaryByUserID(ctx, userID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), summary.Count)
+	require.Empty(t, summary.Recent)
+	require.Empty(t, summary.TopTags)
+
+	summary, err = linksRepo.SummaryByUserID(ctx, uuid.New().String(), 5, 5)
+	require.NoError(t, err)
+	require.Zero(t, summary.Count)
+}
+
+func TestRepository_RestoreByUserID(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	require.NoError(t, linksRepo.EnsureIndexes(ctx))
+
+	userID := uuid.New().String()
+	ids := make([]primitive.ObjectID, 3)
+	for i := range ids {
+		ids[i] = primitive.NewObjectID()
+		canonicalURL := fmt.Sprintf("https://ya.ru/%d", i)
+		_, err := linksRepo.Create(
+			ctx, database.CreateLinkReq{ID: ids[i], URL: canonicalURL, CanonicalURL: canonicalURL, UserID: userID},
+		)
+		require.NoError(t, err)
+	}
+
+	// ссылку, удаленную самим пользователем, восстановление пользователя не возвращает
+	require.NoError(t, linksRepo.Delete(ctx, ids[0]))
+	deleted, err := linksRepo.DeleteByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	// пока пользователь был в корзине, тот же url сохранили заново
+	_, err = linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID: primitive.NewObjectID(), URL: "https://ya.ru/1", CanonicalURL: "https://ya.ru/1", UserID: userID,
+		},
+	)
+	require.NoError(t, err)
+
+	restored, err := linksRepo.RestoreByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), restored)
+
+	_, err = linksRepo.FindByID(ctx, ids[2])
+	require.NoError(t, err)
+	for _, id := range ids[:2] {
+		_, err = linksRepo.FindDeletedByID(ctx, id)
+		require.NoError(t, err)
 	}
+
+	// повторная доставка события ничего не меняет
+	restored, err = linksRepo.RestoreByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Zero(t, restored)
 }
@@ -0,0 +1,70 @@
+package links
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// linkSchema $jsonSchema коллекции links. Обязательны только поля, которые есть у ссылок всех версий, а незнакомые
+// поля разрешены, чтобы новые версии сервиса могли добавлять свои
+var linkSchema = bson.M{
+	"bsonType": "object",
+	"required": bson.A{"id", "url", "user_id", "created_at", "updated_at"},
+	"properties": bson.M{
+		"id":            bson.M{"bsonType": "objectId"},
+		"url":           bson.M{"bsonType": "string", "minLength": 1},
+		"canonical_url": bson.M{"bsonType": "string"},
+		"title":         bson.M{"bsonType": "string"},
+		"description":   bson.M{"bsonType": "string"},
+		"images":        bson.M{"bsonType": bson.A{"array", "null"}, "items": bson.M{"bsonType": "string"}},
+		"tags":          bson.M{"bsonType": bson.A{"array", "null"}, "items": bson.M{"bsonType": "string"}},
+		"user_id":       bson.M{"bsonType": "string"},
+		"short_code":    bson.M{"bsonType": "string"},
+		"created_at":    bson.M{"bsonType": "date"},
+		"updated_at":    bson.M{"bsonType": "date"},
+		// живая ссылка хранится без поля, но фильтры deleted_at: nil считают живой и ссылку с null
+		"deleted_at": bson.M{"bsonType": bson.A{"date", "null"}},
+		// ссылка попала в корзину вместе с пользователем и вернется, когда его восстановят
+		"deleted_with_user": bson.M{"bsonType": "bool"},
+		"version":           bson.M{"bsonType": bson.A{"int", "long"}},
+		"health": bson.M{
+			"bsonType": "object",
+			"required": bson.A{"status_code", "checked_at", "failure_streak"},
+			"properties": bson.M{
+				"status_code":    bson.M{"bsonType": bson.A{"int", "long"}},
+				"checked_at":     bson.M{"bsonType": "date"},
+				"failure_streak": bson.M{"bsonType": bson.A{"int", "long"}},
+			},
+		},
+	},
+}
+
+type ValidatorParams struct {
+	Level  string // strict или moderate: moderate не проверяет изменения документов, которые уже не проходят схему
+	Action string // error отклоняет запись, warn только пишет в журнал mongo
+}
+
+// EnsureValidator устанавливает $jsonSchema коллекции links, создавая коллекцию, если ее еще нет
+func (r *Repository) EnsureValidator(ctx context.Context, params ValidatorParams) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if err := r.db.CreateCollection(ctx, collection); err != nil && !isNamespaceExists(err) {
+		return fmt.Errorf("mongo CreateCollection: %w", err)
+	}
+
+	if err := r.db.RunCommand(
+		ctx, bson.D{
+			{Key: "collMod", Value: collection},
+			{Key: "validator", Value: bson.M{"$jsonSchema": linkSchema}},
+			{Key: "validationLevel", Value: params.Level},
+			{Key: "validationAction", Value: params.Action},
+		},
+	).Err(); err != nil {
+		return fmt.Errorf("mongo collMod: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package migrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// NilVersion версия базы, к которой не применено ни одной миграции
+const NilVersion int64 = -1
+
+// defaultTable таблица версии в формате golang-migrate: базы, размеченные CLI, продолжают работать с Migrator
+// и наоборот
+const defaultTable = "schema_migrations"
+
+// lockID ключ advisory lock, под которым реплики users-srv применяют миграции по очереди
+const lockID int64 = 5_172_093_642
+
+var ErrDirty = errors.New("database is dirty")
+
+var fileRe = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+type migration struct {
+	version int64
+	name    string
+	up      string
+	down    *string // без down-файла миграцию нельзя откатить
+}
+
+// querier пул или соединение, которое держит блокировку
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+type MigrationStatus struct {
+	Version int64
+	Name    string
+	Applied bool
+}
+
+type Status struct {
+	Version    int64 // NilVersion, если миграции не применялись
+	Dirty      bool  // миграция Version упала на середине, схему нужно поправить руками и выполнить Force
+	Migrations []MigrationStatus
+}
+
+// Migrator применяет миграции из fs.FS. Файлы сами управляют транзакциями (BEGIN; ... END;), поэтому перед
+// миграцией версия помечается dirty и снимается отметка только после ее успешного выполнения
+type Migrator struct {
+	db         *pgxpool.Pool
+	table      string
+	migrations []migration
+}
+
+func New(db *pgxpool.Pool, fsys fs.FS) (*Migrator, error) {
+	migrations, err := parse(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, table: defaultTable, migrations: migrations}, nil
+}
+
+// Up применяет все миграции новее текущей версии и возвращает их количество
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	var applied int
+	err := m.withLock(
+		ctx, func(conn *pgxpool.Conn) error {
+			version, err := m.checkVersion(ctx, conn)
+			if err != nil {
+				return err
+			}
+
+			for _, mg := range m.migrations {
+				if mg.version <= version {
+					continue
+				}
+
+				if err := m.run(ctx, conn, mg.version, mg.up); err != nil {
+					return fmt.Errorf("migration %d_%s up: %w", mg.version, mg.name, err)
+				}
+				slog.Info("migration applied", slog.Int64("version", mg.version), slog.String("name", mg.name))
+				applied++
+			}
+
+			return nil
+		},
+	)
+
+	return applied, err
+}
+
+// Down откатывает steps последних примененных миграций и возвращает количество откаченных
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	var reverted int
+	err := m.withLock(
+		ctx, func(conn *pgxpool.Conn) error {
+			version, err := m.checkVersion(ctx, conn)
+			if err != nil {
+				return err
+			}
+			if version == NilVersion {
+				return nil
+			}
+
+			i := m.index(version)
+			if i < 0 {
+				return fmt.Errorf("version %d is not found in migrations", version)
+			}
+
+			for ; i >= 0 && reverted < steps; i-- {
+				mg := m.migrations[i]
+				if mg.down == nil {
+					return fmt.Errorf("migration %d_%s has no down file", mg.version, mg.name)
+				}
+
+				prev := NilVersion
+				if i > 0 {
+					prev = m.migrations[i-1].version
+				}
+				if err := m.run(ctx, conn, prev, *mg.down); err != nil {
+					return fmt.Errorf("migration %d_%s down: %w", mg.version, mg.name, err)
+				}
+				slog.Info("migration reverted", slog.Int64("version", mg.version), slog.String("name", mg.name))
+				reverted++
+			}
+
+			return nil
+		},
+	)
+
+	return reverted, err
+}
+
+// Status только читает таблицу версии: без блокировки, поэтому не ждет реплику, которая сейчас применяет миграции.
+// Если таблицы еще нет, миграции не применялись и версия NilVersion
+func (m *Migrator) Status(ctx context.Context) (Status, error) {
+	st := Status{Version: NilVersion}
+
+	var exists bool
+	if err := m.db.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, m.tableIdent()).Scan(&exists); err != nil {
+		return Status{}, fmt.Errorf("postgres QueryRow: %w", err)
+	}
+	if exists {
+		var err error
+		if st.Version, st.Dirty, err = m.version(ctx, m.db); err != nil {
+			return Status{}, err
+		}
+	}
+
+	st.Migrations = make([]MigrationStatus, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		st.Migrations = append(
+			st.Migrations, MigrationStatus{Version: mg.version, Name: mg.name, Applied: mg.version <= st.Version},
+		)
+	}
+
+	return st, nil
+}
+
+// Force записывает версию и снимает отметку dirty, не выполняя миграций. Нужен после ручного исправления схемы
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	if version != NilVersion && m.index(version) < 0 {
+		return fmt.Errorf("version %d is not found in migrations", version)
+	}
+
+	return m.withLock(
+		ctx, func(conn *pgxpool.Conn) error {
+			return m.setVersion(ctx, conn, version, false)
+		},
+	)
+}
+
+// withLock advisory lock сессионный, поэтому все запросы под ним идут через одно соединение
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres Acquire: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, lockID); err != nil {
+		return fmt.Errorf("postgres pg_advisory_lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, lockID); err != nil {
+			// закрытое соединение не вернется в пул с захваченной блокировкой
+			_ = conn.Conn().Close(context.Background())
+		}
+	}()
+
+	if _, err := conn.Exec(
+		ctx, `CREATE TABLE IF NOT EXISTS `+m.tableIdent()+` (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)`,
+	); err != nil {
+		return fmt.Errorf("postgres Exec: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) run(ctx context.Context, conn *pgxpool.Conn, target int64, body string) error {
+	if err := m.setVersion(ctx, conn, target, true); err != nil {
+		return err
+	}
+
+	// без аргументов pgx выполняет запрос простым протоколом, в котором допустимо несколько команд
+	if _, err := conn.Exec(ctx, body); err != nil {
+		return fmt.Errorf("postgres Exec: %w", err)
+	}
+
+	return m.setVersion(ctx, conn, target, false)
+}
+
+func (m *Migrator) checkVersion(ctx context.Context, conn *pgxpool.Conn) (int64, error) {
+	version, dirty, err := m.version(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w at version %d: fix the schema and run force", ErrDirty, version)
+	}
+
+	return version, nil
+}
+
+func (m *Migrator) version(ctx context.Context, conn querier) (int64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := conn.QueryRow(ctx, `SELECT version, dirty FROM `+m.tableIdent()+` LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return NilVersion, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("postgres QueryRow: %w", err)
+	}
+
+	return version, dirty, nil
+}
+
+// setVersion таблица хранит одну строку, как в golang-migrate. NilVersion без dirty означает пустую таблицу
+func (m *Migrator) setVersion(ctx context.Context, conn *pgxpool.Conn, version int64, dirty bool) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres Begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if _, err := tx.Exec(ctx, `TRUNCATE `+m.tableIdent()); err != nil {
+		return fmt.Errorf("postgres Exec: %w", err)
+	}
+	if version != NilVersion || dirty {
+		if _, err := tx.Exec(
+			ctx, `INSERT INTO `+m.tableIdent()+` (version, dirty) VALUES ($1, $2)`, version, dirty,
+		); err != nil {
+			return fmt.Errorf("postgres Exec: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres Commit: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Migrator) index(version int64) int {
+	for i, mg := range m.migrations {
+		if mg.version == version {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (m *Migrator) tableIdent() string {
+	return pgx.Identifier{m.table}.Sanitize()
+}
+
+// parse читает миграции из корня fsys. Файлы, не похожие на миграции, пропускаются
+func parse(fsys fs.FS) ([]migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("fs ReadDir: %w", err)
+	}
+
+	byVersion := make(map[int64]*migration)
+	for _, e := range entries {
+		match := fileRe.FindStringSubmatch(e.Name())
+		if e.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", e.Name(), err)
+		}
+
+		body, err := fs.ReadFile(fsys, e.Name())
+		if err != nil {
+			return nil, fmt.Errorf("fs ReadFile: %w", err)
+		}
+
+		mg, ok := byVersion[version]
+		if !ok {
+			mg = &migration{version: version, name: match[2]}
+			byVersion[version] = mg
+		}
+		if mg.name != match[2] {
+			return nil, fmt.Errorf("migration %d has different names: %s and %s", version, mg.name, match[2])
+		}
+
+		if match[3] == "up" {
+			mg.up = string(body)
+		} else {
+			down := string(body)
+			mg.down = &down
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		if mg.up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", mg.version, mg.name)
+		}
+		migrations = append(migrations, *mg)
+	}
+	sort.Slice(
+		migrations, func(i, j int) bool {
+			return migrations[i].version < migrations[j].version
+		},
+	)
+
+	return migrations, nil
+}
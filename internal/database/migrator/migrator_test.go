@@ -0,0 +1,131 @@
+package migrator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/sethvargo/go-envconfig"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/env/config"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/migrations"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	list, err := parse(
+		fstest.MapFS{
+			"000002_b.up.sql":   {Data: []byte("b up")},
+			"000001_a.up.sql":   {Data: []byte("a up")},
+			"000001_a.down.sql": {Data: []byte("a down")},
+			"README.md":         {Data: []byte("not a migration")},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, int64(1), list[0].version)
+	require.Equal(t, "a", list[0].name)
+	require.Equal(t, "a down", *list[0].down)
+	require.Equal(t, int64(2), list[1].version)
+	require.Nil(t, list[1].down)
+
+	_, err = parse(fstest.MapFS{"000001_a.down.sql": {Data: []byte("a down")}})
+	require.Error(t, err)
+
+	_, err = parse(
+		fstest.MapFS{
+			"000001_a.up.sql": {Data: []byte("a up")},
+			"000001_b.up.sql": {Data: []byte("b up")},
+		},
+	)
+	require.Error(t, err)
+
+	// встроенные миграции users-srv
+	list, err = parse(migrations.FS)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), list[0].version)
+	require.Equal(t, "create_users", list[0].name)
+	for _, mg := range list {
+		require.NotNil(t, mg.down, mg.name)
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	var cfg config.Config
+	require.NoError(t, envconfig.Process(ctx, &cfg)) //nolint:typecheck
+
+	db, err := pgxpool.Connect(ctx, cfg.UsersService.Postgres.ConnectionURL())
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+
+	// отдельные таблицы, чтобы не трогать схему users
+	suffix := time.Now().UnixNano()
+	table := fmt.Sprintf("migrator_test_%d", suffix)
+	m, err := New(
+		db, fstest.MapFS{
+			"000001_create.up.sql":   {Data: []byte(fmt.Sprintf("BEGIN; CREATE TABLE %s (id INT); END;", table))},
+			"000001_create.down.sql": {Data: []byte(fmt.Sprintf("DROP TABLE %s;", table))},
+			"000002_column.up.sql":   {Data: []byte(fmt.Sprintf("ALTER TABLE %s ADD COLUMN name TEXT;", table))},
+			"000002_column.down.sql": {Data: []byte(fmt.Sprintf("ALTER TABLE %s DROP COLUMN name;", table))},
+			"000003_broken.up.sql":   {Data: []byte("SELECT * FROM migrator_test_missing;")},
+			"000003_broken.down.sql": {Data: []byte("SELECT 1;")},
+		},
+	)
+	require.NoError(t, err)
+	m.table = fmt.Sprintf("schema_migrations_test_%d", suffix)
+	t.Cleanup(
+		func() {
+			_, _ = db.Exec(context.Background(), "DROP TABLE IF EXISTS "+table)
+			_, _ = db.Exec(context.Background(), "DROP TABLE IF EXISTS "+m.tableIdent())
+		},
+	)
+
+	st, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, NilVersion, st.Version)
+	require.False(t, st.Migrations[0].Applied)
+
+	// Status только читает: таблицу версии создает Up
+	var exists bool
+	require.NoError(t, db.QueryRow(ctx, "SELECT to_regclass($1) IS NOT NULL", m.tableIdent()).Scan(&exists))
+	require.False(t, exists)
+
+	// третья миграция падает и оставляет базу dirty
+	applied, err := m.Up(ctx)
+	require.Error(t, err)
+	require.Equal(t, 2, applied)
+
+	st, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), st.Version)
+	require.True(t, st.Dirty)
+
+	_, err = m.Up(ctx)
+	require.ErrorIs(t, err, ErrDirty)
+
+	require.NoError(t, m.Force(ctx, 2))
+	require.Error(t, m.Force(ctx, 42))
+
+	reverted, err := m.Down(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, reverted)
+
+	st, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, NilVersion, st.Version)
+	require.False(t, st.Dirty)
+
+	require.NoError(t, db.QueryRow(ctx, "SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists))
+	require.False(t, exists)
+}
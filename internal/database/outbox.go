@@ -0,0 +1,24 @@
+package database
+
+const (
+	// TopicUserDeleted пользователь перенесен в корзину, его ссылки нужно перенести туда же
+	TopicUserDeleted = "user.deleted"
+	// TopicUserRestored пользователь восстановлен из корзины, ссылки, удаленные вместе с ним, нужно вернуть
+	TopicUserRestored = "user.restored"
+)
+
+// OutboxEvent событие из таблицы outbox. Payload в JSON, формат задается Topic
+type OutboxEvent struct {
+	ID       int64
+	Topic    string
+	Payload  []byte
+	Attempts int // вместе с текущей попыткой
+}
+
+type UserDeletedEvent struct {
+	UserID string `json:"user_id"`
+}
+
+type UserRestoredEvent struct {
+	UserID string `json:"user_id"`
+}
@@ -0,0 +1,60 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// PageReq запрос страницы для keyset пагинации. Cursor берется из предыдущей страницы, пустой курсор означает
+// первую страницу
+type PageReq struct {
+	Size   int
+	Cursor string
+}
+
+func (p PageReq) Limit() int {
+	switch {
+	case p.Size <= 0:
+		return DefaultPageSize
+	case p.Size > MaxPageSize:
+		return MaxPageSize
+	}
+
+	return p.Size
+}
+
+// Cursor позиция последней записи страницы в порядке сортировки (created_at, id). Для выборок без стабильного
+// ключа сортировки, например по релевантности, вместо позиции хранится смещение. Клиентам отдается только в
+// закодированном виде
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i,omitempty"`
+	Offset    int64     `json:"o,omitempty"`
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(s string) (Cursor, error) {
+	var c Cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("%w: malformed cursor", ErrInvalid)
+	}
+
+	if err := json.Unmarshal(data, &c); err != nil || (c.ID == "" && c.Offset <= 0) {
+		return c, fmt.Errorf("%w: malformed cursor", ErrInvalid)
+	}
+
+	return c, nil
+}
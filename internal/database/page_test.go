@@ -0,0 +1,37 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	c := Cursor{CreatedAt: time.Date(2024, 3, 1, 10, 0, 0, 123000, time.UTC), ID: "65e1a0e8c2a4f1b2c3d4e5f6"}
+
+	decoded, err := DecodeCursor(c.Encode())
+	require.NoError(t, err)
+	require.True(t, c.CreatedAt.Equal(decoded.CreatedAt))
+	require.Equal(t, c.ID, decoded.ID)
+
+	decoded, err = DecodeCursor(Cursor{Offset: 100}.Encode())
+	require.NoError(t, err)
+	require.Equal(t, int64(100), decoded.Offset)
+
+	for _, s := range []string{"!!!", "bm90LWpzb24", "e30"} {
+		_, err := DecodeCursor(s)
+		require.True(t, errors.Is(err, ErrInvalid), s)
+	}
+}
+
+func TestPageReq_Limit(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, DefaultPageSize, PageReq{}.Limit())
+	require.Equal(t, 10, PageReq{Size: 10}.Limit())
+	require.Equal(t, MaxPageSize, PageReq{Size: MaxPageSize + 1}.Limit())
+}
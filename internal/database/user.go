@@ -7,17 +7,26 @@ import (
 )
 
 type User struct {
-	ID        uuid.UUID `db:"id"`
-	Username  string    `db:"username"`
-	Password  string    `db:"password"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uuid.UUID  `db:"id"`
+	Username  string     `db:"username"`
+	Password  string     `db:"password"`
+	Role      string     `db:"role"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"` // не nil, пока пользователь лежит в корзине
+	Version   int64      `db:"version"`    // растет при каждом изменении пользователя
 }
 
 type CreateUserReq struct {
 	ID       uuid.UUID
 	Username string
 	Password string
+	Role     string // пустая роль сохраняет текущую роль пользователя, для нового пользователя это member
+	// ExpectedVersion nil означает запись без проверки версии, как раньше. Только для Update
+	ExpectedVersion *int64
+	// Fields поля для частичного обновления (username, password, role). Если список не пуст, меняются только они.
+	// Только для Update
+	Fields []string
 }
 
 type FindUserCriteria struct {
@@ -0,0 +1,44 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	uniqueViolation           = "23505"
+	checkViolation            = "23514"
+	notNullViolation          = "23502"
+	invalidTextRepresentation = "22P02"
+)
+
+const usernameUniqConstraint = "users_username_uniq_idx"
+
+func convertError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %w", database.ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case uniqueViolation:
+		if pgErr.ConstraintName == usernameUniqConstraint {
+			return fmt.Errorf("%w: username already exists: %w", database.ErrConflict, err)
+		}
+		return fmt.Errorf("%w: %w", database.ErrConflict, err)
+	case checkViolation, notNullViolation, invalidTextRepresentation:
+		return fmt.Errorf("%w: %w", database.ErrInvalid, err)
+	}
+
+	return err
+}
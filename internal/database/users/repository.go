@@ -2,10 +2,15 @@ package users
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
@@ -20,7 +25,12 @@ type Repository struct {
 	timeout time.Duration
 }
 
-// Create этот метод создает пользователя и обновляет его, если такой id уже существует, используйте его для обновления
+// updateFields поля, которые update меняет без маски
+var updateFields = []string{"username", "password", "role"}
+
+// Create только создает пользователя: существующий через Create не меняется. Занятый id вернет ErrConflict, даже
+// если его пользователь в корзине. username занят только живыми пользователями: имя пользователя из корзины можно
+// взять, тогда конфликт вернет уже RestoreByUserID. ExpectedVersion и Fields учитывает только Update
 func (r *Repository) Create(ctx context.Context, req database.CreateUserReq) (database.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -35,73 +45,271 @@ func (r *Repository) Create(ctx context.Context, req database.CreateUserReq) (da
 	}
 
 	query := `
-		INSERT INTO users (id, username, password, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
-		ON CONFLICT (id) DO UPDATE
-		SET username = $2, password = $3, updated_at = $5
+		INSERT INTO users (id, username, password, role, created_at, updated_at)
+		VALUES ($1, $2, $3, COALESCE(NULLIF($4, ''), 'member'), $5, $6)
+		RETURNING role, created_at, version
 	`
-	if _, err := r.db.Exec(ctx, query, u.ID, u.Username, u.Password, now, now); err != nil {
-		return u, fmt.Errorf("postgres Exec: %w", err)
+	if err := r.db.QueryRow(ctx, query, u.ID, u.Username, u.Password, req.Role, now, now).Scan(
+		&u.Role, &u.CreatedAt, &u.Version,
+	); err != nil {
+		return u, fmt.Errorf("postgres QueryRow: %w", convertError(err))
 	}
 
 	return u, nil
 }
 
+// Update меняет существующего пользователя: все поля или только перечисленные в Fields. Отсутствующий пользователь
+// и пользователь из корзины не создаются, для них возвращается ErrNotFound. С ExpectedVersion пользователь меняется,
+// только если его версия совпадает, иначе вернется ErrVersionMismatch
+func (r *Repository) Update(ctx context.Context, req database.CreateUserReq) (database.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	now := time.Now()
+	fields := req.Fields
+	if len(fields) == 0 {
+		fields = updateFields
+	}
+
+	args := []interface{}{req.ID, now}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	set := []string{"updated_at = $2", "version = version + 1"}
+	seen := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if _, ok := seen[field]; ok {
+			continue
+		}
+		seen[field] = struct{}{}
+
+		switch field {
+		case "username":
+			set = append(set, "username = "+arg(req.Username))
+		case "password":
+			set = append(set, "password = "+arg(req.Password))
+		case "role":
+			set = append(set, "role = COALESCE(NULLIF("+arg(req.Role)+", ''), role)")
+		default:
+			return database.User{}, fmt.Errorf("field %s: %w", field, database.ErrInvalid)
+		}
+	}
+
+	where := "id = $1 AND deleted_at IS NULL"
+	if req.ExpectedVersion != nil {
+		where += " AND version = " + arg(*req.ExpectedVersion)
+	}
+
+	query := `UPDATE users SET ` + strings.Join(set, ", ") + ` WHERE ` + where + `
+		RETURNING id, username, password, role, created_at, updated_at, version`
+	var u database.User
+	err := r.db.QueryRow(ctx, query, args...).Scan(
+		&u.ID, &u.Username, &u.Password, &u.Role, &u.CreatedAt, &u.UpdatedAt, &u.Version,
+	)
+	if errors.Is(err, pgx.ErrNoRows) && req.ExpectedVersion != nil {
+		if _, findErr := r.FindByID(ctx, req.ID); findErr == nil {
+			return u, fmt.Errorf("postgres QueryRow: %w", database.ErrVersionMismatch)
+		}
+	}
+	if err != nil {
+		return u, fmt.Errorf("postgres QueryRow: %w", convertError(err))
+	}
+
+	return u, nil
+}
+
+// DeleteByUserID переносит пользователя в корзину. Окончательно его удалит PurgeDeleted после срока хранения.
+// В той же транзакции в outbox пишется TopicUserDeleted, чтобы ссылки пользователя гарантированно отправились за ним
 func (r *Repository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	query := `DELETE FROM users WHERE id=$1`
-	if _, err := r.db.Exec(ctx, query, userID); err != nil {
-		return fmt.Errorf("postgres Exec: %w", err)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres Begin: %w", convertError(err))
 	}
+	defer tx.Rollback(ctx) //nolint:errcheck // после Commit ничего не делает
+
+	query := `UPDATE users SET deleted_at = NOW() WHERE id=$1 AND deleted_at IS NULL`
+	tag, err := tx.Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("postgres Exec: %w", convertError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("postgres Exec: %w", database.ErrNotFound)
+	}
+
+	if err := writeOutbox(
+		ctx, tx, database.TopicUserDeleted, database.UserDeletedEvent{UserID: userID.String()},
+	); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres Commit: %w", convertError(err))
+	}
+
 	return nil
 }
 
-func (r *Repository) FindByID(ctx context.Context, userID uuid.UUID) (database.User, error) {
+// RestoreByUserID достает пользователя из корзины. Если его имя успел занять другой пользователь, вернется ErrConflict.
+// В той же транзакции в outbox пишется TopicUserRestored, чтобы вернуть ссылки, удаленные вместе с пользователем
+func (r *Repository) RestoreByUserID(ctx context.Context, userID uuid.UUID) (database.User, error) {
 	var u database.User
 
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	if err := r.db.QueryRow(ctx, `SELECT * FROM users WHERE id=$1`, userID).Scan(
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return u, fmt.Errorf("postgres Begin: %w", convertError(err))
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // после Commit ничего не делает
+
+	query := `
+		UPDATE users SET deleted_at = NULL WHERE id=$1 AND deleted_at IS NOT NULL
+		RETURNING id, username, password, role, created_at, updated_at, deleted_at, version
+	`
+	if err := tx.QueryRow(ctx, query, userID).Scan(
 		&u.ID, &u.Username,
-		&u.Password, &u.CreatedAt, &u.UpdatedAt,
+		&u.Password, &u.Role, &u.CreatedAt, &u.UpdatedAt, &u.DeletedAt, &u.Version,
 	); err != nil {
-		return u, fmt.Errorf("postgres QueryRow Decode: %w", err)
+		return u, fmt.Errorf("postgres QueryRow Decode: %w", convertError(err))
+	}
+
+	if err := writeOutbox(
+		ctx, tx, database.TopicUserRestored, database.UserRestoredEvent{UserID: userID.String()},
+	); err != nil {
+		return u, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return u, fmt.Errorf("postgres Commit: %w", convertError(err))
 	}
 
 	return u, nil
 }
 
-func (r *Repository) FindAll(ctx context.Context) ([]database.User, error) {
+// writeOutbox записывает событие в outbox в транзакции изменения, которое его вызвало
+func writeOutbox(ctx context.Context, tx pgx.Tx, topic string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("json Marshal: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `INSERT INTO outbox (topic, payload) VALUES ($1, $2)`, topic, payload); err != nil {
+		return fmt.Errorf("postgres Exec outbox: %w", convertError(err))
+	}
+
+	return nil
+}
+
+// PurgeDeleted окончательно удаляет пользователей, которые лежат в корзине с deletedBefore
+func (r *Repository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	var users []database.User
+	tag, err := r.db.Exec(ctx, `DELETE FROM users WHERE deleted_at < $1`, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("postgres Exec: %w", convertError(err))
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, userID uuid.UUID) (database.User, error) {
+	var u database.User
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `
+		SELECT id, username, password, role, created_at, updated_at, version FROM users WHERE id=$1 AND deleted_at IS NULL
+	`
+	if err := r.db.QueryRow(ctx, query, userID).Scan(
+		&u.ID, &u.Username,
+		&u.Password, &u.Role, &u.CreatedAt, &u.UpdatedAt, &u.Version,
+	); err != nil {
+		return u, fmt.Errorf("postgres QueryRow Decode: %w", convertError(err))
+	}
+
+	return u, nil
+}
+
+// FindAll возвращает страницу пользователей в порядке (created_at, id) и курсор следующей страницы. Пустой курсор
+// означает, что страница последняя
+func (r *Repository) FindAll(ctx context.Context, page database.PageReq) ([]database.User, string, error) {
+	return r.findPage(ctx, false, page)
+}
+
+// FindDeleted возвращает страницу пользователей из корзины в том же порядке, что и FindAll
+func (r *Repository) FindDeleted(ctx context.Context, page database.PageReq) ([]database.User, string, error) {
+	return r.findPage(ctx, true, page)
+}
 
-	query := `SELECT id, username, password, created_at, updated_at FROM users`
+func (r *Repository) findPage(
+	ctx context.Context,
+	deleted bool,
+	page database.PageReq,
+) ([]database.User, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
 
-	rows, err := r.db.Query(ctx, query)
+	limit := page.Limit()
+	args := []interface{}{limit + 1}
+	query := `SELECT id, username, password, role, created_at, updated_at, deleted_at, version FROM users`
+	if deleted {
+		query += ` WHERE deleted_at IS NOT NULL`
+	} else {
+		query += ` WHERE deleted_at IS NULL`
+	}
+	if page.Cursor != "" {
+		c, err := database.DecodeCursor(page.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		id, err := uuid.Parse(c.ID)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: malformed cursor", database.ErrInvalid)
+		}
+		query += ` AND (created_at, id) > ($2, $3)`
+		args = append(args, c.CreatedAt, id)
+	}
+	query += ` ORDER BY created_at, id LIMIT $1`
+
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("postgres Query: %w", err)
+		return nil, "", fmt.Errorf("postgres Query: %w", convertError(err))
 	}
 	defer rows.Close()
 
+	users := make([]database.User, 0, limit+1)
 	for rows.Next() {
 		var user database.User
-		err := rows.Scan(&user.ID, &user.Username, &user.Password, &user.CreatedAt, &user.UpdatedAt)
+		err := rows.Scan(
+			&user.ID, &user.Username, &user.Password, &user.Role, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt,
+			&user.Version,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
+			return nil, "", fmt.Errorf("failed to scan row: %w", err)
 		}
 		users = append(users, user)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error during rows iteration: %w", err)
+		return nil, "", fmt.Errorf("error during rows iteration: %w", err)
+	}
+
+	var next string
+	if len(users) > limit {
+		users = users[:limit]
+		last := users[limit-1]
+		next = database.Cursor{CreatedAt: last.CreatedAt, ID: last.ID.String()}.Encode()
 	}
 
-	return users, nil
+	return users, next, nil
 }
 
 func (r *Repository) FindByUsername(ctx context.Context, username string) (database.User, error) {
@@ -110,12 +318,91 @@ func (r *Repository) FindByUsername(ctx context.Context, username string) (datab
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	if err := r.db.QueryRow(ctx, `SELECT * FROM users WHERE username=$1`, username).Scan(
+	query := `
+		SELECT id, username, password, role, created_at, updated_at, version
+		FROM users WHERE username=$1 AND deleted_at IS NULL
+	`
+	if err := r.db.QueryRow(ctx, query, username).Scan(
 		&u.ID, &u.Username,
-		&u.Password, &u.CreatedAt, &u.UpdatedAt,
+		&u.Password, &u.Role, &u.CreatedAt, &u.UpdatedAt, &u.Version,
 	); err != nil {
-		return u, fmt.Errorf("postgres QueryRow Decode: %w", err)
+		return u, fmt.Errorf("postgres QueryRow Decode: %w", convertError(err))
 	}
 
 	return u, nil
 }
+
+// ClaimOutbox забирает до limit событий, которым пора доставляться, и откладывает их до leaseUntil. Пока отправитель
+// не подтвердил доставку, события недоступны другим репликам, а после его падения вернутся в очередь сами
+func (r *Repository) ClaimOutbox(ctx context.Context, limit int, leaseUntil time.Time) ([]database.OutboxEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `
+		UPDATE outbox SET attempts = attempts + 1, next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM outbox WHERE dead_at IS NULL AND next_attempt_at <= NOW()
+			ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, topic, payload, attempts
+	`
+	rows, err := r.db.Query(ctx, query, limit, leaseUntil)
+	if err != nil {
+		return nil, fmt.Errorf("postgres Query: %w", convertError(err))
+	}
+	defer rows.Close()
+
+	var events []database.OutboxEvent
+	for rows.Next() {
+		var e database.OutboxEvent
+		if err := rows.Scan(&e.ID, &e.Topic, &e.Payload, &e.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during rows iteration: %w", err)
+	}
+
+	return events, nil
+}
+
+// CompleteOutbox удаляет доставленное событие
+func (r *Repository) CompleteOutbox(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Exec(ctx, `DELETE FROM outbox WHERE id=$1`, id); err != nil {
+		return fmt.Errorf("postgres Exec: %w", convertError(err))
+	}
+
+	return nil
+}
+
+// RetryOutbox откладывает недоставленное событие до nextAttempt и запоминает причину
+func (r *Repository) RetryOutbox(ctx context.Context, id int64, nextAttempt time.Time, lastErr string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `UPDATE outbox SET next_attempt_at = $2, last_error = $3 WHERE id=$1`
+	if _, err := r.db.Exec(ctx, query, id, nextAttempt, lastErr); err != nil {
+		return fmt.Errorf("postgres Exec: %w", convertError(err))
+	}
+
+	return nil
+}
+
+// DeadLetterOutbox прекращает доставку события, у которого кончились попытки. Событие остается в outbox с dead_at и
+// причиной последней неудачи, чтобы его можно было разобрать вручную
+func (r *Repository) DeadLetterOutbox(ctx context.Context, id int64, lastErr string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `UPDATE outbox SET dead_at = NOW(), last_error = $2 WHERE id=$1`
+	if _, err := r.db.Exec(ctx, query, id, lastErr); err != nil {
+		return fmt.Errorf("postgres Exec: %w", convertError(err))
+	}
+
+	return nil
+}
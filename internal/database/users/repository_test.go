@@ -3,6 +3,7 @@ package users
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -14,7 +15,6 @@ import (
 
 	"github.com/go-playground/assert/v2"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/sethvargo/go-envconfig"
 	"github.com/stretchr/testify/require"
@@ -106,17 +106,114 @@ func TestRepository_Create(t *testing.T) {
 		assert.Equal(t, created.Password, u.Password)
 	}
 	{
+		// существующий пользователь не перезаписывается, даже с другим именем и паролем
 		_, err := usersRepo.Create(
 			ctx, database.CreateUserReq{
 				ID:       u.ID,
-				Username: u.Username,
-				Password: u.Password,
+				Username: u.Username + "_1",
+				Password: "new",
+				Role:     "admin",
 			},
 		)
+		require.ErrorIs(t, err, database.ErrConflict)
+
+		found, err := usersRepo.FindByID(ctx, u.ID)
 		require.NoError(t, err)
+		require.Equal(t, u.Username, found.Username)
+		require.Equal(t, u.Password, found.Password)
+		require.Equal(t, "member", found.Role)
 	}
 }
 
+func TestRepository_UpdateExpectedVersion(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	u, err := generateUser()
+	require.NoError(t, err)
+
+	created, err := usersRepo.Create(
+		ctx, database.CreateUserReq{ID: u.ID, Username: u.Username, Password: u.Password},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), created.Version)
+
+	version := created.Version
+	updated, err := usersRepo.Update(
+		ctx, database.CreateUserReq{
+			ID: u.ID, Username: u.Username + "_1", Password: u.Password, ExpectedVersion: &version,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.Version)
+
+	_, err = usersRepo.Update(
+		ctx, database.CreateUserReq{
+			ID: u.ID, Username: u.Username + "_2", Password: u.Password, ExpectedVersion: &version,
+		},
+	)
+	require.ErrorIs(t, err, database.ErrVersionMismatch)
+
+	found, err := usersRepo.FindByID(ctx, u.ID)
+	require.NoError(t, err)
+	require.Equal(t, u.Username+"_1", found.Username)
+
+	// с ожидаемой версией пользователь не создается
+	other, err := generateUser()
+	require.NoError(t, err)
+	_, err = usersRepo.Update(
+		ctx, database.CreateUserReq{
+			ID: other.ID, Username: other.Username, Password: other.Password, ExpectedVersion: &version,
+		},
+	)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func TestRepository_UpdateFields(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	u, err := generateUser()
+	require.NoError(t, err)
+
+	_, err = usersRepo.Create(ctx, u)
+	require.NoError(t, err)
+
+	updated, err := usersRepo.Update(
+		ctx, database.CreateUserReq{ID: u.ID, Username: u.Username + "_1", Fields: []string{"username"}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, u.Username+"_1", updated.Username)
+	require.Equal(t, u.Password, updated.Password)
+	require.Equal(t, "member", updated.Role)
+
+	_, err = usersRepo.Update(ctx, database.CreateUserReq{ID: u.ID, Fields: []string{"id"}})
+	require.ErrorIs(t, err, database.ErrInvalid)
+
+	other, err := generateUser()
+	require.NoError(t, err)
+	_, err = usersRepo.Update(
+		ctx, database.CreateUserReq{ID: other.ID, Username: other.Username, Fields: []string{"username"}},
+	)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// без маски меняются все поля, но отсутствующий пользователь тоже не создается
+	_, err = usersRepo.Update(ctx, other)
+	require.ErrorIs(t, err, database.ErrNotFound)
+	_, err = usersRepo.FindByID(ctx, other.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
 func TestRepository_FindAll(t *testing.T) {
 	t.Parallel()
 
@@ -138,7 +235,7 @@ func TestRepository_FindAll(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	all, err := usersRepo.FindAll(ctx)
+	all, _, err := usersRepo.FindAll(ctx, database.PageReq{})
 	require.NoError(t, err)
 
 	require.GreaterOrEqual(t, len(all), 1)
@@ -176,10 +273,173 @@ func TestRepository_DeleteByUserID(t *testing.T) {
 
 	{
 		_, err := usersRepo.FindByID(ctx, u.ID)
-		if err != nil {
-			if !errors.Is(err, pgx.ErrNoRows) {
-				t.Fatal(err)
+		require.True(t, errors.Is(err, database.ErrNotFound))
+	}
+
+	{
+		err := usersRepo.DeleteByUserID(ctx, u.ID)
+		require.True(t, errors.Is(err, database.ErrNotFound))
+	}
+}
+
+func TestRepository_CreateConflict(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	u, err := generateUser()
+	require.NoError(t, err)
+
+	_, err = usersRepo.Create(ctx, u)
+	require.NoError(t, err)
+
+	_, err = usersRepo.Create(
+		ctx, database.CreateUserReq{
+			ID:       uuid.New(),
+			Username: u.Username,
+			Password: u.Password,
+		},
+	)
+	require.True(t, errors.Is(err, database.ErrConflict))
+}
+
+func TestRepository_RestoreByUserID(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	u, err := generateUser()
+	require.NoError(t, err)
+
+	_, err = usersRepo.Create(ctx, u)
+	require.NoError(t, err)
+	require.NoError(t, usersRepo.DeleteByUserID(ctx, u.ID))
+
+	// пользователя из корзины нельзя изменить, а его имя свободно
+	_, err = usersRepo.Update(ctx, database.CreateUserReq{ID: u.ID, Username: u.Username, Password: "new"})
+	require.True(t, errors.Is(err, database.ErrNotFound))
+
+	_, err = usersRepo.FindByUsername(ctx, u.Username)
+	require.True(t, errors.Is(err, database.ErrNotFound))
+
+	namesake, err := generateUser()
+	require.NoError(t, err)
+	namesake.Username = u.Username
+	_, err = usersRepo.Create(ctx, namesake)
+	require.NoError(t, err)
+
+	_, err = usersRepo.RestoreByUserID(ctx, u.ID)
+	require.True(t, errors.Is(err, database.ErrConflict))
+
+	require.NoError(t, usersRepo.DeleteByUserID(ctx, namesake.ID))
+
+	restored, err := usersRepo.RestoreByUserID(ctx, u.ID)
+	require.NoError(t, err)
+	require.Nil(t, restored.DeletedAt)
+	require.Equal(t, u.Username, restored.Username)
+
+	_, err = usersRepo.RestoreByUserID(ctx, u.ID)
+	require.True(t, errors.Is(err, database.ErrNotFound))
+
+	trash, _, err := usersRepo.FindDeleted(ctx, database.PageReq{Size: 500})
+	require.NoError(t, err)
+
+	var found bool
+	for _, user := range trash {
+		require.NotNil(t, user.DeletedAt)
+		found = found || user.ID == namesake.ID
+	}
+	require.True(t, found)
+}
+
+// TestRepository_PurgeDeleted не параллельный: очистка затронула бы корзину других тестов
+func TestRepository_PurgeDeleted(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	u, err := generateUser()
+	require.NoError(t, err)
+
+	_, err = usersRepo.Create(ctx, u)
+	require.NoError(t, err)
+	require.NoError(t, usersRepo.DeleteByUserID(ctx, u.ID))
+
+	purged, err := usersRepo.PurgeDeleted(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, purged, int64(1))
+
+	_, err = usersRepo.RestoreByUserID(ctx, u.ID)
+	require.True(t, errors.Is(err, database.ErrNotFound))
+}
+
+// TestRepository_Outbox не параллельный: ClaimOutbox забирает и события других тестов
+func TestRepository_Outbox(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	u, err := generateUser()
+	require.NoError(t, err)
+
+	_, err = usersRepo.Create(ctx, u)
+	require.NoError(t, err)
+	require.NoError(t, usersRepo.DeleteByUserID(ctx, u.ID))
+
+	claim := func(topic string) (database.OutboxEvent, bool) {
+		events, err := usersRepo.ClaimOutbox(ctx, 500, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+
+		for _, e := range events {
+			var payload database.UserDeletedEvent
+			require.NoError(t, json.Unmarshal(e.Payload, &payload))
+			if e.Topic == topic && payload.UserID == u.ID.String() {
+				return e, true
 			}
 		}
+
+		return database.OutboxEvent{}, false
 	}
+
+	event, ok := claim(database.TopicUserDeleted)
+	require.True(t, ok)
+	require.Equal(t, 1, event.Attempts)
+
+	// пока действует lease, событие никому не выдается
+	_, ok = claim(database.TopicUserDeleted)
+	require.False(t, ok)
+
+	require.NoError(t, usersRepo.RetryOutbox(ctx, event.ID, time.Now().Add(-time.Second), "links-srv is unavailable"))
+	event, ok = claim(database.TopicUserDeleted)
+	require.True(t, ok)
+	require.Equal(t, 2, event.Attempts)
+
+	require.NoError(t, usersRepo.CompleteOutbox(ctx, event.ID))
+	require.NoError(t, usersRepo.RetryOutbox(ctx, event.ID, time.Now().Add(-time.Second), ""))
+	_, ok = claim(database.TopicUserDeleted)
+	require.False(t, ok)
+
+	// восстановление пишет свое событие
+	_, err = usersRepo.RestoreByUserID(ctx, u.ID)
+	require.NoError(t, err)
+	event, ok = claim(database.TopicUserRestored)
+	require.True(t, ok)
+
+	// событие, исчерпавшее попытки, больше не выдается
+	require.NoError(t, usersRepo.DeadLetterOutbox(ctx, event.ID, "links-srv is unavailable"))
+	require.NoError(t, usersRepo.RetryOutbox(ctx, event.ID, time.Now().Add(-time.Second), ""))
+	_, ok = claim(database.TopicUserRestored)
+	require.False(t, ok)
 }
@@ -0,0 +1,35 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Visit переход по короткой ссылке. IP клиента хранится только в виде хеша
+type Visit struct {
+	LinkID    primitive.ObjectID `bson:"link_id"`
+	VisitedAt time.Time          `bson:"visited_at"`
+	Referrer  string             `bson:"referrer,omitempty"`
+	UserAgent string             `bson:"user_agent,omitempty"`
+	IPHash    string             `bson:"ip_hash"`
+}
+
+type FindVisitsCriteria struct {
+	LinkID        primitive.ObjectID
+	VisitedAfter  *time.Time
+	VisitedBefore *time.Time
+}
+
+// LinkStats уникальные посетители считаются по IPHash
+type LinkStats struct {
+	Total          int64
+	UniqueVisitors int64
+	Daily          []DailyVisits // по возрастанию дат, дни без переходов пропускаются
+}
+
+type DailyVisits struct {
+	Day            time.Time // начало дня в UTC
+	Visits         int64
+	UniqueVisitors int64
+}
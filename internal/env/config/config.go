@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -11,11 +12,96 @@ type Config struct {
 	UsersService UsersService `env:",prefix=USERS_"`
 	LinksService LinksService `env:",prefix=LINKS_"`
 	ApiGWService ApiGWService `env:",prefix=APIGW_"`
+	Auth         AuthConfig   `env:",prefix=AUTH_"`
+}
+
+// Validate проверяет параметры без безопасного значения по умолчанию. Секреты не имеют дефолтов в репозитории:
+// развертывание, которое забыло их задать, не должно запуститься с известным всем ключом
+func (c Config) Validate() error {
+	if c.Auth.Secret == "" {
+		return errors.New("AUTH_SECRET is required")
+	}
+	// по известной соли хеши IP посетителей перебираются за минуты
+	if c.LinksService.Visits.IPSalt == "" {
+		return errors.New("LINKS_VISITS_IP_SALT is required")
+	}
+
+	return nil
+}
+
+// AuthConfig общий для users-srv, который выпускает токены, и api-gw, который их проверяет
+type AuthConfig struct {
+	Secret     string        `env:"SECRET" json:"-"` // обязателен, см. Validate
+	Issuer     string        `env:"ISSUER,default=umanager"`
+	AccessTTL  time.Duration `env:"ACCESS_TTL,default=15m"`
+	RefreshTTL time.Duration `env:"REFRESH_TTL,default=720h"`
 }
 
 type LinksService struct {
 	Mongo      MongoConfig     `env:",prefix=DB_"`
 	GRPCServer LinksGRPCConfig `env:",prefix=GRPC_"`
+	ShortCode  ShortCodeConfig `env:",prefix=SHORT_CODE_"`
+	Visits     VisitsConfig    `env:",prefix=VISITS_"`
+	Metadata   MetadataConfig  `env:",prefix=METADATA_"`
+	Health     HealthConfig    `env:",prefix=HEALTH_"`
+	Trash      TrashConfig     `env:",prefix=TRASH_"`
+	Owners     OwnersConfig    `env:",prefix=OWNERS_"`
+	Schema     SchemaConfig    `env:",prefix=SCHEMA_"`
+}
+
+// SchemaConfig $jsonSchema коллекции links, устанавливается при запуске, если Validate включен
+type SchemaConfig struct {
+	Validate bool   `env:"VALIDATE,default=false"`
+	Level    string `env:"LEVEL,default=moderate"` // strict или moderate
+	Action   string `env:"ACTION,default=error"`   // error или warn
+}
+
+// OwnersConfig проверка владельца ссылки в users-srv при создании и изменении ссылки
+type OwnersConfig struct {
+	Disabled        bool          `env:"DISABLED,default=false"` // для запуска links-srv без users-srv
+	UsersClientAddr string        `env:"USERS_CLIENT_ADDR,default=:52000"`
+	CacheTTL        time.Duration `env:"CACHE_TTL,default=30s"`
+	MaxEntries      int           `env:"MAX_ENTRIES,default=10000"`
+	Timeout         time.Duration `env:"TIMEOUT,default=2s"`
+}
+
+type ShortCodeConfig struct {
+	Length      int `env:"LENGTH,default=7"`
+	MaxAttempts int `env:"MAX_ATTEMPTS,default=5"` // попыток сгенерировать незанятый код
+}
+
+// VisitsConfig переходы копятся в очереди и пишутся пачками. При переполнении очереди новые переходы теряются
+type VisitsConfig struct {
+	IPSalt        string        `env:"IP_SALT" json:"-"` // обязательна, см. Validate
+	BufferSize    int           `env:"BUFFER_SIZE,default=10000"`
+	BatchSize     int           `env:"BATCH_SIZE,default=100"`
+	FlushInterval time.Duration `env:"FLUSH_INTERVAL,default=1s"`
+}
+
+// MetadataConfig загрузка страниц ссылок для title, description и images
+type MetadataConfig struct {
+	Timeout      time.Duration `env:"TIMEOUT,default=5s"`             // на всю загрузку, включая редиректы
+	MaxBodyBytes int64         `env:"MAX_BODY_BYTES,default=1048576"` // дальше страница не читается
+	MaxImages    int           `env:"MAX_IMAGES,default=5"`
+	Workers      int           `env:"WORKERS,default=4"`
+	BufferSize   int           `env:"BUFFER_SIZE,default=1000"`
+}
+
+// HealthConfig периодическая проверка доступности url ссылок
+type HealthConfig struct {
+	Interval     time.Duration `env:"INTERVAL,default=10m"`      // как часто искать ссылки для проверки
+	RecheckAfter time.Duration `env:"RECHECK_AFTER,default=24h"` // через сколько проверять ссылку повторно
+	BatchSize    int           `env:"BATCH_SIZE,default=500"`
+	Workers      int           `env:"WORKERS,default=8"`
+	Timeout      time.Duration `env:"TIMEOUT,default=10s"`      // на одну проверку, включая редиректы
+	HostInterval time.Duration `env:"HOST_INTERVAL,default=1s"` // между запросами к одному хосту
+	BrokenAfter  int           `env:"BROKEN_AFTER,default=3"`   // неудачных проверок подряд до попадания в битые
+}
+
+// TrashConfig корзина удаленных записей. Записи старше Retention удаляются окончательно
+type TrashConfig struct {
+	Retention     time.Duration `env:"RETENTION,default=720h"`
+	PurgeInterval time.Duration `env:"PURGE_INTERVAL,default=1h"`
 }
 
 type LinksGRPCConfig struct {
@@ -41,6 +127,24 @@ func (m MongoConfig) ConnectionString() string {
 type UsersService struct {
 	Postgres   PostgresConfig  `env:",prefix=DB_"`
 	GRPCServer UsersGRPCConfig `env:",prefix=GRPC_"`
+	Password   PasswordConfig  `env:",prefix=PASSWORD_"`
+	Trash      TrashConfig     `env:",prefix=TRASH_"`
+	Outbox     OutboxConfig    `env:",prefix=OUTBOX_"`
+	// links-srv получает из outbox события об удалении пользователей
+	LinksClientAddr string `env:"LINKS_CLIENT_ADDR,default=:51000"`
+	// применять новые миграции при запуске. Без этого схема обновляется подкомандой users-srv migrate up
+	MigrateOnStart bool `env:"MIGRATE_ON_START,default=true"`
+}
+
+// OutboxConfig доставка событий users-srv в другие сервисы. Недоставленное событие повторяется с удвоением задержки
+type OutboxConfig struct {
+	Interval   time.Duration `env:"INTERVAL,default=1s"`
+	BatchSize  int           `env:"BATCH_SIZE,default=100"`
+	Lease      time.Duration `env:"LEASE,default=1m"` // больше Timeout, иначе событие уйдет второй реплике
+	Timeout    time.Duration `env:"TIMEOUT,default=30s"`
+	MaxBackoff time.Duration `env:"MAX_BACKOFF,default=10m"`
+	// после стольких попыток событие больше не доставляется. С настройками по умолчанию это около 9 часов
+	MaxAttempts int `env:"MAX_ATTEMPTS,default=60"`
 }
 
 type UsersGRPCConfig struct {
@@ -48,6 +152,15 @@ type UsersGRPCConfig struct {
 	Timeout time.Duration `env:"TIMEOUT,default=10s"`
 }
 
+// PasswordConfig параметры argon2id. При изменении параметров хеши пользователей пересчитываются при следующем входе
+type PasswordConfig struct {
+	Memory      uint32 `env:"MEMORY,default=65536"` // KiB
+	Iterations  uint32 `env:"ITERATIONS,default=3"`
+	Parallelism uint8  `env:"PARALLELISM,default=2"`
+	SaltLength  uint32 `env:"SALT_LENGTH,default=16"`
+	KeyLength   uint32 `env:"KEY_LENGTH,default=32"`
+}
+
 type PostgresConfig struct {
 	Name         string        `env:"NAME,default=users" json:",omitempty"`
 	User         string        `env:"USER,default=postgres" json:",omitempty"`
@@ -96,4 +209,21 @@ type ApiGWService struct {
 	WriteTimeout    time.Duration `env:"WRITE_TIMEOUT,default=30s"`
 	UsersClientAddr string        `env:"USERS_CLIENT_ADDR,default=:52000"`
 	LinksClientAddr string        `env:"USERS_CLIENT_ADDR,default=:51000"`
+	RedirectStatus  int           `env:"REDIRECT_STATUS,default=302"` // 301 или 302 для GET /r/{code}
+	// включать, только если api-gw доступен исключительно через прокси, который сам выставляет X-Forwarded-For
+	TrustProxyHeaders bool `env:"TRUST_PROXY_HEADERS,default=false"`
+	// сверять ответы со спецификацией и отдавать 500 на расхождения. Для тестов и отладки
+	ValidateResponses bool              `env:"VALIDATE_RESPONSES,default=false"`
+	Idempotency       IdempotencyConfig `env:",prefix=IDEMPOTENCY_"`
+}
+
+// IdempotencyConfig ответы на запросы с Idempotency-Key. Store memory годится для одной реплики api-gw, с
+// несколькими репликами нужен postgres: таблица общая, создается миграциями users-srv
+type IdempotencyConfig struct {
+	// Store memory или postgres. postgres использует таблицу idempotency_keys в базе users-srv: она создается его
+	// миграциями, поэтому api-gw с этим хранилищем запускается после users-srv
+	Store         string        `env:"STORE,default=memory"`
+	TTL           time.Duration `env:"TTL,default=24h"`
+	Lease         time.Duration `env:"LEASE,default=1m"` // сколько ключ занят выполняющимся запросом
+	PurgeInterval time.Duration `env:"PURGE_INTERVAL,default=1h"`
 }
@@ -16,11 +16,23 @@ import (
 
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/routes"
 	v1 "gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/apigw/v1"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database/idempotency"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database/links"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database/migrator"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database/users"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/env/config"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/health"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/linkgrpc"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/metadata"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/owners"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/shortcode"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/visits"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/trash"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/user/outbox"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/user/password"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/user/usergrpc"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/migrations"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
 )
 
@@ -29,6 +41,16 @@ type Env struct {
 	ApiGWHTTPServer *http.Server
 	LinksGRPCServer *grpc.Server
 	UsersGRPCServer *grpc.Server
+	LinksRepository *links.Repository
+	VisitRecorder   *visits.Recorder
+	LinksEnricher   *metadata.Enricher
+	HealthChecker   *health.Checker
+	LinksPurger     *trash.Purger
+	UsersPurger     *trash.Purger
+	UsersMigrator   *migrator.Migrator
+	OutboxRelay     *outbox.Relay
+
+	IdempotencyPurger *idempotency.Purger
 }
 
 func Setup(ctx context.Context) (*Env, error) {
@@ -39,6 +61,10 @@ func Setup(ctx context.Context) (*Env, error) {
 		return nil, fmt.Errorf("env processing: %w", err)
 	}
 
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("config Validate: %w", err)
+	}
+
 	linksDBConn, err := mongo.Connect(
 		ctx, &options.ClientOptions{
 			ConnectTimeout: &cfg.LinksService.Mongo.ConnectTimeout,
@@ -56,14 +82,101 @@ func Setup(ctx context.Context) (*Env, error) {
 		return nil, fmt.Errorf("pgxpool Connect: %w", err)
 	}
 
+	env.UsersMigrator, err = migrator.New(usersDBConn, migrations.FS)
+	if err != nil {
+		return nil, fmt.Errorf("migrator New: %w", err)
+	}
+
 	usersRepository := users.New(usersDBConn, 5*time.Second) // вынести в конфиг duration
 	linksRepository := links.New(
 		linksDBConn.Database(cfg.LinksService.Mongo.Name),
 		5*time.Second, // вынести в конфиг duration
 	)
 
+	env.LinksRepository = linksRepository
+
+	tokenManager := auth.New(
+		[]byte(cfg.Auth.Secret), cfg.Auth.Issuer, cfg.Auth.AccessTTL, cfg.Auth.RefreshTTL,
+	)
+
+	visitRecorder := visits.New(
+		linksRepository, visits.Params{
+			Salt:          []byte(cfg.LinksService.Visits.IPSalt),
+			BufferSize:    cfg.LinksService.Visits.BufferSize,
+			BatchSize:     cfg.LinksService.Visits.BatchSize,
+			FlushInterval: cfg.LinksService.Visits.FlushInterval,
+		},
+	)
+
+	env.VisitRecorder = visitRecorder
+
+	linksEnricher := metadata.NewEnricher(
+		metadata.NewFetcher(
+			metadata.NewHTTPClient(cfg.LinksService.Metadata.Timeout),
+			cfg.LinksService.Metadata.Timeout,
+			cfg.LinksService.Metadata.MaxBodyBytes,
+			cfg.LinksService.Metadata.MaxImages,
+		),
+		linksRepository,
+		cfg.LinksService.Metadata.Workers,
+		cfg.LinksService.Metadata.BufferSize,
+	)
+
+	env.LinksEnricher = linksEnricher
+
+	env.HealthChecker = health.New(
+		linksRepository,
+		metadata.NewHTTPClient(cfg.LinksService.Health.Timeout),
+		health.Params{
+			Interval:     cfg.LinksService.Health.Interval,
+			RecheckAfter: cfg.LinksService.Health.RecheckAfter,
+			BatchSize:    cfg.LinksService.Health.BatchSize,
+			Workers:      cfg.LinksService.Health.Workers,
+			Timeout:      cfg.LinksService.Health.Timeout,
+			HostInterval: cfg.LinksService.Health.HostInterval,
+		},
+	)
+
+	env.LinksPurger = trash.New(
+		"links", linksRepository, trash.Params{
+			Interval:  cfg.LinksService.Trash.PurgeInterval,
+			Retention: cfg.LinksService.Trash.Retention,
+		},
+	)
+	env.UsersPurger = trash.New(
+		"users", usersRepository, trash.Params{
+			Interval:  cfg.UsersService.Trash.PurgeInterval,
+			Retention: cfg.UsersService.Trash.Retention,
+		},
+	)
+
 	{
-		handler := linkgrpc.New(linksRepository, cfg.LinksService.GRPCServer.Timeout)
+		// клиент users-srv для проверки владельцев ссылок
+		usersClientConn, err := grpc.DialContext(
+			ctx, cfg.LinksService.Owners.UsersClientAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("grpc DialContext: %w", err)
+		}
+
+		handler := linkgrpc.New(
+			linksRepository, linkgrpc.Params{
+				ShortCodes:        shortcode.New(cfg.LinksService.ShortCode.Length),
+				ShortCodeAttempts: cfg.LinksService.ShortCode.MaxAttempts,
+				Visits:            visitRecorder,
+				Metadata:          linksEnricher,
+				Owners: owners.New(
+					pb.NewUserServiceClient(usersClientConn), owners.Params{
+						Disabled:   cfg.LinksService.Owners.Disabled,
+						CacheTTL:   cfg.LinksService.Owners.CacheTTL,
+						MaxEntries: cfg.LinksService.Owners.MaxEntries,
+						Timeout:    cfg.LinksService.Owners.Timeout,
+					},
+				),
+				BrokenAfter: cfg.LinksService.Health.BrokenAfter,
+				Timeout:     cfg.LinksService.GRPCServer.Timeout,
+			},
+		)
 
 		s := grpc.NewServer()
 		reflection.Register(s) // этот код нужен для дебаггинга
@@ -74,7 +187,35 @@ func Setup(ctx context.Context) (*Env, error) {
 	}
 
 	{
-		handler := usergrpc.New(usersRepository, cfg.LinksService.GRPCServer.Timeout)
+		passwordHasher := password.New(
+			password.Params{
+				Memory:      cfg.UsersService.Password.Memory,
+				Iterations:  cfg.UsersService.Password.Iterations,
+				Parallelism: cfg.UsersService.Password.Parallelism,
+				SaltLength:  cfg.UsersService.Password.SaltLength,
+				KeyLength:   cfg.UsersService.Password.KeyLength,
+			},
+		)
+		handler := usergrpc.New(usersRepository, passwordHasher, tokenManager, cfg.LinksService.GRPCServer.Timeout)
+
+		// клиент links-srv для доставки событий из outbox
+		linksClientConn, err := grpc.DialContext(
+			ctx, cfg.UsersService.LinksClientAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("grpc DialContext: %w", err)
+		}
+
+		env.OutboxRelay = outbox.New(
+			usersRepository, pb.NewLinkServiceClient(linksClientConn), outbox.Params{
+				Interval:    cfg.UsersService.Outbox.Interval,
+				BatchSize:   cfg.UsersService.Outbox.BatchSize,
+				Lease:       cfg.UsersService.Outbox.Lease,
+				Timeout:     cfg.UsersService.Outbox.Timeout,
+				MaxBackoff:  cfg.UsersService.Outbox.MaxBackoff,
+				MaxAttempts: cfg.UsersService.Outbox.MaxAttempts,
+			},
+		)
 
 		s := grpc.NewServer()
 		reflection.Register(s) // этот код нужен для дебаггинга
@@ -108,8 +249,36 @@ func Setup(ctx context.Context) (*Env, error) {
 
 	// API GW handler
 	// В роуйтере пакета v1 нужно использовать клиенты и запрашивать данные с сервисов links и users
-	handler := v1.New(usersClient, linksClient)
-	router := routes.Router(handler)
+	handler := v1.New(
+		usersClient, linksClient, cfg.ApiGWService.RedirectStatus, cfg.ApiGWService.TrustProxyHeaders,
+	)
+
+	idempotencyParams := routes.IdempotencyParams{
+		TTL:   cfg.ApiGWService.Idempotency.TTL,
+		Lease: cfg.ApiGWService.Idempotency.Lease,
+	}
+	switch cfg.ApiGWService.Idempotency.Store {
+	case "memory":
+		store := idempotency.NewMemory()
+		idempotencyParams.Store = store
+		env.IdempotencyPurger = idempotency.NewPurger(store, cfg.ApiGWService.Idempotency.PurgeInterval)
+	case "postgres":
+		store := idempotency.New(usersDBConn, 5*time.Second) // вынести в конфиг duration
+		idempotencyParams.Store = store
+		env.IdempotencyPurger = idempotency.NewPurger(store, cfg.ApiGWService.Idempotency.PurgeInterval)
+	default:
+		return nil, fmt.Errorf("unknown idempotency store %q", cfg.ApiGWService.Idempotency.Store)
+	}
+
+	router, err := routes.Router(
+		handler,
+		tokenManager,
+		routes.ValidationParams{Responses: cfg.ApiGWService.ValidateResponses},
+		idempotencyParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("routes Router: %w", err)
+	}
 
 	apiGWServer := &http.Server{
 		Addr:              cfg.ApiGWService.Addr,
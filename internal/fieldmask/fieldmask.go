@@ -0,0 +1,14 @@
+package fieldmask
+
+import (
+	"slices"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Contains сообщает, меняет ли запрос с маской mask поле field. Пустая маска означает обновление всех полей
+func Contains(mask *fieldmaskpb.FieldMask, field string) bool {
+	paths := mask.GetPaths()
+
+	return len(paths) == 0 || slices.Contains(paths, field)
+}
@@ -0,0 +1,17 @@
+package fieldmask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, Contains(nil, "url"))
+	require.True(t, Contains(&fieldmaskpb.FieldMask{}, "url"))
+	require.True(t, Contains(&fieldmaskpb.FieldMask{Paths: []string{"title", "url"}}, "url"))
+	require.False(t, Contains(&fieldmaskpb.FieldMask{Paths: []string{"title"}}, "url"))
+}
@@ -0,0 +1,99 @@
+package grpcerr
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+// Причины в ErrorInfo. По ним клиенты различают ошибки с одинаковым кодом, не разбирая текст сообщения
+const (
+	ReasonNotFound        = "NOT_FOUND"
+	ReasonVersionMismatch = "VERSION_MISMATCH"
+	ReasonConflict        = "CONFLICT"
+	ReasonDuplicateURL    = "DUPLICATE_URL"
+	ReasonShortCodeTaken  = "SHORT_CODE_TAKEN"
+	ReasonInvalidArgument = "INVALID_ARGUMENT"
+	ReasonOwnerNotFound   = "OWNER_NOT_FOUND"
+	ReasonUnavailable     = "UNAVAILABLE"
+	ReasonTimeout         = "TIMEOUT"
+	ReasonCanceled        = "CANCELED"
+	ReasonInternal        = "INTERNAL"
+)
+
+// Domain сервис, от имени которого собираются статусы. Попадает в ErrorInfo.Domain
+type Domain string
+
+// Error статус с деталями и ErrorInfo последним. Сообщение уходит клиенту как есть, поэтому причины из драйверов
+// баз в него не передаются. Если детали приложить не удалось, статус уходит без них
+func (d Domain) Error(
+	code codes.Code,
+	reason string,
+	message string,
+	details ...protoadapt.MessageV1,
+) error {
+	st := status.New(code, message)
+	details = append(details, &errdetails.ErrorInfo{Reason: reason, Domain: string(d)})
+	if detailed, err := st.WithDetails(details...); err == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
+
+// InvalidArgument INVALID_ARGUMENT с errdetails.BadRequest по одному полю запроса
+func (d Domain) InvalidArgument(field, description string) error {
+	return d.Error(
+		codes.InvalidArgument,
+		ReasonInvalidArgument,
+		field+": "+description,
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+		},
+	)
+}
+
+// Resource ResourceInfo записи, к которой относится ошибка
+func Resource(resourceType, name string) *errdetails.ResourceInfo {
+	return &errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: name}
+}
+
+// FromError переводит ошибки репозитория в статус. Текст исходной ошибки с префиксами драйвера клиенту не отдается,
+// вместо него сообщение по sentinel-ошибке, resource подставляется в него, например "link not found". details
+// прикладываются перед ErrorInfo, обычно это ResourceInfo записи, с которой работал запрос
+func (d Domain) FromError(err error, resource string, details ...protoadapt.MessageV1) error {
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		return d.Error(codes.NotFound, ReasonNotFound, resource+" not found", details...)
+	case errors.Is(err, database.ErrVersionMismatch):
+		return d.Error(codes.Aborted, ReasonVersionMismatch, database.ErrVersionMismatch.Error(), details...)
+	case errors.Is(err, database.ErrShortCodeTaken):
+		return d.Error(codes.AlreadyExists, ReasonShortCodeTaken, database.ErrShortCodeTaken.Error(), details...)
+	case errors.Is(err, database.ErrDuplicateURL):
+		return d.Error(codes.AlreadyExists, ReasonDuplicateURL, database.ErrDuplicateURL.Error(), details...)
+	case errors.Is(err, database.ErrConflict):
+		return d.Error(codes.AlreadyExists, ReasonConflict, resource+" already exists", details...)
+	case errors.Is(err, database.ErrInvalid):
+		return d.Error(codes.InvalidArgument, ReasonInvalidArgument, "invalid argument", details...)
+	case errors.Is(err, context.DeadlineExceeded):
+		return d.Error(codes.DeadlineExceeded, ReasonTimeout, "deadline exceeded")
+	case errors.Is(err, context.Canceled):
+		return d.Error(codes.Canceled, ReasonCanceled, "request canceled")
+	}
+
+	return d.Internal(err)
+}
+
+// Internal пишет причину в лог, а клиенту отдает только код
+func (d Domain) Internal(err error) error {
+	slog.Error("internal error", slog.String("domain", string(d)), slog.String("err", err.Error()))
+
+	return d.Error(codes.Internal, ReasonInternal, "internal error")
+}
@@ -0,0 +1,96 @@
+package grpcerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+func TestDomain_Error(t *testing.T) {
+	t.Parallel()
+
+	const domain Domain = "links.umanager"
+
+	st := status.Convert(domain.Error(codes.NotFound, ReasonNotFound, "link not found", Resource("link", "1")))
+	require.Equal(t, codes.NotFound, st.Code())
+	require.Equal(t, "link not found", st.Message())
+	require.Len(t, st.Details(), 2)
+	require.Equal(t, "1", st.Details()[0].(*errdetails.ResourceInfo).ResourceName)
+
+	info := st.Details()[1].(*errdetails.ErrorInfo)
+	require.Equal(t, ReasonNotFound, info.Reason)
+	require.Equal(t, string(domain), info.Domain)
+}
+
+func TestDomain_InvalidArgument(t *testing.T) {
+	t.Parallel()
+
+	st := status.Convert(Domain("users.umanager").InvalidArgument("id", "invalid UUID length: 3"))
+	require.Equal(t, codes.InvalidArgument, st.Code())
+
+	violations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+	require.Len(t, violations, 1)
+	require.Equal(t, "id", violations[0].Field)
+	require.Equal(t, "invalid UUID length: 3", violations[0].Description)
+	require.Equal(t, ReasonInvalidArgument, st.Details()[1].(*errdetails.ErrorInfo).Reason)
+}
+
+func TestDomain_FromError(t *testing.T) {
+	t.Parallel()
+
+	const domain Domain = "users.umanager"
+
+	for name, tc := range map[string]struct {
+		err     error
+		code    codes.Code
+		reason  string
+		message string
+	}{
+		"not found": {
+			err:     fmt.Errorf("postgres QueryRow: %w", database.ErrNotFound),
+			code:    codes.NotFound,
+			reason:  ReasonNotFound,
+			message: "user not found",
+		},
+		"conflict": {
+			err:     fmt.Errorf("postgres QueryRow: %w: duplicate key", database.ErrConflict),
+			code:    codes.AlreadyExists,
+			reason:  ReasonConflict,
+			message: "user already exists",
+		},
+		"duplicate url": {
+			err:     fmt.Errorf("mongo InsertOne: %w", database.ErrDuplicateURL),
+			code:    codes.AlreadyExists,
+			reason:  ReasonDuplicateURL,
+			message: database.ErrDuplicateURL.Error(),
+		},
+		"deadline": {
+			err:     fmt.Errorf("postgres QueryRow: %w", context.DeadlineExceeded),
+			code:    codes.DeadlineExceeded,
+			reason:  ReasonTimeout,
+			message: "deadline exceeded",
+		},
+		"internal": {
+			err:     errors.New("postgres QueryRow: connection refused"),
+			code:    codes.Internal,
+			reason:  ReasonInternal,
+			message: "internal error",
+		},
+	} {
+		st := status.Convert(domain.FromError(tc.err, "user"))
+		require.Equal(t, tc.code, st.Code(), name)
+		require.Equal(t, tc.message, st.Message(), name)
+
+		info := st.Details()[len(st.Details())-1].(*errdetails.ErrorInfo)
+		require.Equal(t, tc.reason, info.Reason, name)
+		require.Equal(t, string(domain), info.Domain, name)
+	}
+}
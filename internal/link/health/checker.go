@@ -0,0 +1,204 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+// сколько тела ответа GET дочитываем, чтобы соединение вернулось в пул
+const maxDrainBytes = 4 << 10
+
+type linksRepository interface {
+	FindDueForCheck(ctx context.Context, checkedBefore time.Time, limit int64) ([]database.Link, error)
+	UpdateHealth(ctx context.Context, id primitive.ObjectID, check database.HealthCheck) error
+}
+
+type httpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type Params struct {
+	Interval     time.Duration // как часто искать ссылки для проверки
+	RecheckAfter time.Duration // через сколько проверять ссылку повторно
+	BatchSize    int           // ссылок за один проход
+	Workers      int
+	Timeout      time.Duration // на одну проверку, включая редиректы
+	HostInterval time.Duration // минимальный интервал между запросами к одному хосту
+}
+
+// Checker периодически проверяет доступность url ссылок. Запросы к одному хосту разнесены во времени, чтобы не
+// нагружать чужие сайты, когда у пользователей много ссылок на один домен
+type Checker struct {
+	repo   linksRepository
+	client httpClient
+	params Params
+	hosts  *hostLimiter
+}
+
+func New(repo linksRepository, client httpClient, params Params) *Checker {
+	return &Checker{repo: repo, client: client, params: params, hosts: newHostLimiter(params.HostInterval)}
+}
+
+// Run проверяет ссылки пачками каждые Interval до отмены ctx
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.params.Interval)
+	defer ticker.Stop()
+
+	for {
+		c.checkDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) checkDue(ctx context.Context) {
+	links, err := c.repo.FindDueForCheck(ctx, time.Now().Add(-c.params.RecheckAfter), int64(c.params.BatchSize))
+	if err != nil {
+		slog.Error("links FindDueForCheck", slog.Any("err", err))
+		return
+	}
+
+	jobs := make(chan database.Link)
+
+	var wg sync.WaitGroup
+	for i := 0; i < c.params.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for l := range jobs {
+				c.check(ctx, l)
+			}
+		}()
+	}
+
+	defer wg.Wait()
+	defer close(jobs)
+
+	for _, l := range links {
+		select {
+		case jobs <- l:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Checker) check(ctx context.Context, l database.Link) {
+	u, err := url.Parse(l.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		c.save(ctx, l, database.HealthCheck{Error: "unsupported url", CheckedAt: time.Now()})
+		return
+	}
+
+	if err := c.hosts.wait(ctx, u.Hostname()); err != nil {
+		return
+	}
+
+	result := c.probe(ctx, u.String())
+	if ctx.Err() != nil {
+		// остановка сервиса не повод считать ссылку недоступной
+		return
+	}
+
+	c.save(ctx, l, result)
+}
+
+func (c *Checker) save(ctx context.Context, l database.Link, result database.HealthCheck) {
+	if err := c.repo.UpdateHealth(ctx, l.ID, result); err != nil && !errors.Is(err, database.ErrNotFound) {
+		slog.Error("links UpdateHealth", slog.String("link_id", l.ID.Hex()), slog.Any("err", err))
+	}
+}
+
+// probe запрашивает rawURL методом HEAD, а если сервер его не поддерживает, то GET. Доступной считается ссылка,
+// которая после редиректов ответила 2xx
+func (c *Checker) probe(ctx context.Context, rawURL string) database.HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, c.params.Timeout)
+	defer cancel()
+
+	resp, err := c.do(ctx, http.MethodHead, rawURL)
+	if err == nil && (resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented) {
+		resp.Body.Close()
+		resp, err = c.do(ctx, http.MethodGet, rawURL)
+	}
+
+	result := database.HealthCheck{CheckedAt: time.Now()}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.CopyN(io.Discard, resp.Body, maxDrainBytes)
+
+	result.StatusCode = resp.StatusCode
+	result.OK = resp.StatusCode >= 200 && resp.StatusCode <= 299
+	if final := resp.Request.URL.String(); final != rawURL {
+		result.RedirectURL = final
+	}
+
+	return result
+}
+
+func (c *Checker) do(ctx context.Context, method, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.Do(req)
+}
+
+// hostLimiter выдает каждому хосту слоты не чаще interval. Слот резервируется сразу, поэтому ожидающие запросы
+// к одному хосту выстраиваются в очередь, а не просыпаются одновременно
+type hostLimiter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	next     map[string]time.Time
+}
+
+func newHostLimiter(interval time.Duration) *hostLimiter {
+	return &hostLimiter{interval: interval, next: make(map[string]time.Time)}
+}
+
+func (l *hostLimiter) wait(ctx context.Context, host string) error {
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next[host]
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next[host] = slot.Add(l.interval)
+
+	// старые записи больше не ограничивают, не даем карте расти вместе с числом хостов
+	for h, next := range l.next {
+		if next.Before(now) {
+			delete(l.next, h)
+		}
+	}
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(slot))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
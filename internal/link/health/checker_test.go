@@ -0,0 +1,173 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+type stubRepository struct {
+	mu      sync.Mutex
+	due     []database.Link
+	checked map[primitive.ObjectID]database.HealthCheck
+}
+
+func (r *stubRepository) FindDueForCheck(_ context.Context, _ time.Time, _ int64) ([]database.Link, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	due := r.due
+	r.due = nil
+
+	return due, nil
+}
+
+func (r *stubRepository) UpdateHealth(_ context.Context, id primitive.ObjectID, check database.HealthCheck) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checked[id] = check
+
+	return nil
+}
+
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"/ok", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	)
+	mux.HandleFunc(
+		"/get-only", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		},
+	)
+	mux.HandleFunc(
+		"/moved", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+		},
+	)
+	mux.HandleFunc(
+		"/gone", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestChecker_probe(t *testing.T) {
+	t.Parallel()
+
+	server := newServer(t)
+	c := New(&stubRepository{}, server.Client(), Params{Timeout: time.Second})
+	ctx := context.Background()
+
+	result := c.probe(ctx, server.URL+"/ok")
+	require.True(t, result.OK)
+	require.Equal(t, http.StatusOK, result.StatusCode)
+	require.Empty(t, result.RedirectURL)
+
+	result = c.probe(ctx, server.URL+"/get-only")
+	require.True(t, result.OK)
+
+	result = c.probe(ctx, server.URL+"/moved")
+	require.True(t, result.OK)
+	require.Equal(t, server.URL+"/ok", result.RedirectURL)
+
+	result = c.probe(ctx, server.URL+"/gone")
+	require.False(t, result.OK)
+	require.Equal(t, http.StatusGone, result.StatusCode)
+
+	result = c.probe(ctx, "http://127.0.0.1:1/closed")
+	require.False(t, result.OK)
+	require.Zero(t, result.StatusCode)
+	require.NotEmpty(t, result.Error)
+}
+
+func TestChecker_Run(t *testing.T) {
+	t.Parallel()
+
+	server := newServer(t)
+	ok, gone, invalid := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	repo := &stubRepository{
+		due: []database.Link{
+			{ID: ok, URL: server.URL + "/ok"},
+			{ID: gone, URL: server.URL + "/gone"},
+			{ID: invalid, URL: "mailto:someone@example.com"},
+		},
+		checked: make(map[primitive.ObjectID]database.HealthCheck),
+	}
+	c := New(
+		repo, server.Client(), Params{
+			Interval:  time.Hour,
+			BatchSize: 10,
+			Workers:   2,
+			Timeout:   time.Second,
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Run(ctx)
+	}()
+
+	require.Eventually(
+		t, func() bool {
+			repo.mu.Lock()
+			defer repo.mu.Unlock()
+
+			return len(repo.checked) == 3
+		}, time.Second, 10*time.Millisecond,
+	)
+	cancel()
+	<-done
+
+	require.True(t, repo.checked[ok].OK)
+	require.False(t, repo.checked[gone].OK)
+	require.False(t, repo.checked[invalid].OK)
+	require.Equal(t, "unsupported url", repo.checked[invalid].Error)
+}
+
+func TestHostLimiter_wait(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newHostLimiter(50 * time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.wait(ctx, "example.com"))
+	}
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// другой хост не ждет очереди первого
+	start = time.Now()
+	require.NoError(t, l.wait(ctx, "example.org"))
+	require.Less(t, time.Since(start), 50*time.Millisecond)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, l.wait(ctx, "example.net"))
+	require.ErrorIs(t, l.wait(cancelled, "example.net"), context.Canceled)
+}
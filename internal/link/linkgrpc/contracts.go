@@ -6,13 +6,43 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/visits"
 )
 
 type linksRepository interface {
 	Create(ctx context.Context, req database.CreateLinkReq) (database.Link, error)
 	Update(ctx context.Context, req database.UpdateLinkReq) (database.Link, error)
 	Delete(ctx context.Context, id primitive.ObjectID) error
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+	RestoreByUserID(ctx context.Context, userID string) (int64, error)
+	Restore(ctx context.Context, id primitive.ObjectID) (database.Link, error)
 	FindByID(ctx context.Context, id primitive.ObjectID) (database.Link, error)
-	FindByUserID(ctx context.Context, userID string) ([]database.Link, error)
-	FindAll(ctx context.Context) ([]database.Link, error)
+	FindDeletedByID(ctx context.Context, id primitive.ObjectID) (database.Link, error)
+	FindByShortCode(ctx context.Context, code string) (database.Link, error)
+	FindByUserAndURL(ctx context.Context, canonicalURL, userID string) (database.Link, error)
+	FindByUserID(ctx context.Context, userID string, page database.PageReq) ([]database.Link, string, error)
+	SummaryByUserID(ctx context.Context, userID string, recent, topTags int) (database.LinksSummary, error)
+	FindAll(ctx context.Context, page database.PageReq) ([]database.Link, string, error)
+	FindPageByCriteria(
+		ctx context.Context,
+		criteria database.FindLinkCriteria,
+		page database.PageReq,
+	) ([]database.Link, string, error)
+	VisitStats(ctx context.Context, criteria database.FindVisitsCriteria) (database.LinkStats, error)
+}
+
+type shortCodeGenerator interface {
+	Generate() (string, error)
+}
+
+type metadataQueue interface {
+	Enqueue(linkID primitive.ObjectID, url string) bool
+}
+
+type visitRecorder interface {
+	Record(v visits.Visit) bool
+}
+
+type ownerValidator interface {
+	Exists(ctx context.Context, userID string) (bool, error)
 }
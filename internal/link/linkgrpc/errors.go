@@ -0,0 +1,34 @@
+package linkgrpc
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/protoadapt"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/grpcerr"
+)
+
+// domain попадает в ErrorInfo всех статусов links-srv
+const domain grpcerr.Domain = "links.umanager"
+
+// statusFromError статус links-srv по ошибке репозитория
+func statusFromError(err error, details ...protoadapt.MessageV1) error {
+	return domain.FromError(err, "link", details...)
+}
+
+// linkResource ResourceInfo ссылки, к которой относится ошибка
+func linkResource(id primitive.ObjectID) protoadapt.MessageV1 {
+	return grpcerr.Resource("link", id.Hex())
+}
+
+// duplicateURLStatus AlreadyExists с идентификатором ссылки, которая уже сохранена с тем же url. Идентификатор
+// дублируется в ResourceInfo, чтобы клиенту не приходилось разбирать текст ошибки
+func duplicateURLStatus(existing primitive.ObjectID) error {
+	return domain.Error(
+		codes.AlreadyExists,
+		grpcerr.ReasonDuplicateURL,
+		database.ErrDuplicateURL.Error()+": "+existing.Hex(),
+		linkResource(existing),
+	)
+}
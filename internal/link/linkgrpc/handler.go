@@ -3,26 +3,62 @@ package linkgrpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"log/slog"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/fieldmask"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/grpcerr"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/shortcode"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/urlnorm"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/visits"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
 )
 
 var _ pb.LinkServiceServer = (*Handler)(nil)
 
-func New(linksRepository linksRepository, timeout time.Duration) *Handler {
-	return &Handler{linksRepository: linksRepository, timeout: timeout}
+// maxSummaryItems сколько ссылок и тегов можно запросить в сводке
+const maxSummaryItems = 100
+
+// Params зависимости и настройки Handler
+type Params struct {
+	ShortCodes        shortCodeGenerator
+	ShortCodeAttempts int // сколько кодов сгенерировать, прежде чем сдаться, если все они заняты
+	Visits            visitRecorder
+	Metadata          metadataQueue
+	Owners            ownerValidator
+	BrokenAfter       int // неудачных проверок подряд, после которых ссылка считается битой
+	Timeout           time.Duration
+}
+
+func New(linksRepository linksRepository, params Params) *Handler {
+	return &Handler{
+		linksRepository:   linksRepository,
+		shortCodes:        params.ShortCodes,
+		shortCodeAttempts: params.ShortCodeAttempts,
+		visits:            params.Visits,
+		metadata:          params.Metadata,
+		owners:            params.Owners,
+		brokenAfter:       params.BrokenAfter,
+		timeout:           params.Timeout,
+	}
 }
 
 type Handler struct {
 	pb.UnimplementedLinkServiceServer
-	linksRepository linksRepository
-	timeout         time.Duration
+	linksRepository   linksRepository
+	shortCodes        shortCodeGenerator
+	shortCodeAttempts int
+	visits            visitRecorder
+	metadata          metadataQueue
+	owners            ownerValidator
+	brokenAfter       int // неудачных проверок подряд, после которых ссылка считается битой
+	timeout           time.Duration
 }
 
 func (h Handler) GetLinkByUserID(ctx context.Context, id *pb.GetLinksByUserId) (*pb.ListLinkResponse, error) {
@@ -30,62 +66,144 @@ func (h Handler) GetLinkByUserID(ctx context.Context, id *pb.GetLinksByUserId) (
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
-	list, err := h.linksRepository.FindByUserID(ctx, id.UserId)
+	criteria := database.FindLinkCriteria{UserID: &id.UserId}
+	switch id.Sort {
+	case pb.SortOrder_SORT_ORDER_CREATED_DESC:
+		criteria.Sort = database.SortCreatedDesc
+	case pb.SortOrder_SORT_ORDER_RELEVANCE:
+		return nil, domain.InvalidArgument("sort", "relevance sort requires query")
+	}
+
+	list, next, err := h.linksRepository.FindPageByCriteria(
+		ctx, criteria, database.PageReq{Size: int(id.PageSize), Cursor: id.PageToken},
+	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err)
 	}
 
-	response := make([]*pb.Link, 0, len(list))
+	return &pb.ListLinkResponse{Links: linksToPB(list), NextPageToken: next}, nil
+}
 
-	for _, l := range list {
-		response = append(
-			response, &pb.Link{
-				Id:        l.ID.Hex(),
-				Title:     l.Title,
-				Url:       l.URL,
-				Images:    l.Images,
-				Tags:      l.Tags,
-				UserId:    l.UserID,
-				CreatedAt: l.CreatedAt.Format(time.RFC3339),
-				UpdatedAt: l.UpdatedAt.Format(time.RFC3339),
-			},
-		)
+// GetLinksSummary сводка для профиля пользователя. Считается в базе по всем его ссылкам, сколько бы их ни было
+func (h Handler) GetLinksSummary(ctx context.Context, request *pb.GetLinksSummaryRequest) (*pb.LinksSummary, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	switch {
+	case request.UserId == "":
+		return nil, domain.InvalidArgument("user_id", "must not be empty")
+	case request.Recent < 0 || request.Recent > maxSummaryItems:
+		return nil, domain.InvalidArgument("recent", fmt.Sprintf("must be between 0 and %d", maxSummaryItems))
+	case request.TopTags < 0 || request.TopTags > maxSummaryItems:
+		return nil, domain.InvalidArgument("top_tags", fmt.Sprintf("must be between 0 and %d", maxSummaryItems))
 	}
 
-	return &pb.ListLinkResponse{Links: response}, nil
+	summary, err := h.linksRepository.SummaryByUserID(
+		ctx, request.UserId, int(request.Recent), int(request.TopTags),
+	)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &pb.LinksSummary{
+		Count:   summary.Count,
+		Recent:  linksToPB(summary.Recent),
+		TopTags: make([]*pb.TagCount, 0, len(summary.TopTags)),
+	}
+	for _, t := range summary.TopTags {
+		response.TopTags = append(response.TopTags, &pb.TagCount{Tag: t.Tag, Count: t.Count})
+	}
+
+	return response, nil
 }
 
 func (h Handler) mustEmbedUnimplementedLinkServiceServer() {
 	// nothing to implement here
 }
 
-func (h Handler) CreateLink(ctx context.Context, request *pb.CreateLinkRequest) (*pb.Empty, error) {
+func (h Handler) CreateLink(ctx context.Context, request *pb.CreateLinkRequest) (*pb.Link, error) {
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
 	// implemented
-	objectID, err := primitive.ObjectIDFromHex(request.Id)
+	objectID, err := newLinkID(request.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, domain.InvalidArgument("id", err.Error())
 	}
 
-	if _, err := h.linksRepository.Create(
-		ctx, database.CreateLinkReq{
-			ID:     objectID,
-			URL:    request.Url,
-			Title:  request.Title,
-			Tags:   request.Tags,
-			Images: request.Images,
-			UserID: request.UserId,
+	canonicalURL, err := urlnorm.Normalize(request.Url)
+	if err != nil {
+		return nil, domain.InvalidArgument("url", err.Error())
+	}
+
+	if err := h.checkOwner(ctx, request.UserId); err != nil {
+		return nil, err
+	}
+
+	if request.ShortCode != "" {
+		if err := shortcode.ValidateAlias(request.ShortCode); err != nil {
+			return nil, domain.InvalidArgument("short_code", err.Error())
+		}
+	}
+
+	var created database.Link
+	if err := h.withShortCode(
+		request.ShortCode, func(code string) error {
+			var err error
+			created, err = h.linksRepository.Create(
+				ctx, database.CreateLinkReq{
+					ID:           objectID,
+					URL:          request.Url,
+					CanonicalURL: canonicalURL,
+					Title:        request.Title,
+					Description:  request.Description,
+					Tags:         request.Tags,
+					Images:       request.Images,
+					UserID:       request.UserId,
+					ShortCode:    code,
+				},
+			)
+			return err
 		},
 	); err != nil {
-		if errors.Is(err, errors.New("conflict")) {
-			return nil, status.Error(codes.AlreadyExists, err.Error())
+		return nil, h.saveError(ctx, err, canonicalURL, request.UserId)
+	}
+
+	if request.Title == "" || request.Description == "" || len(request.Images) == 0 {
+		if !h.metadata.Enqueue(objectID, request.Url) {
+			slog.Warn("metadata queue is full, link skipped", slog.String("link_id", objectID.Hex()))
 		}
-		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.Empty{}, nil
+	return linkToPB(created), nil
+}
+
+// newLinkID пустой id заменяется новым ObjectID
+func newLinkID(id string) (primitive.ObjectID, error) {
+	if id == "" {
+		return primitive.NewObjectID(), nil
+	}
+
+	return primitive.ObjectIDFromHex(id)
+}
+
+// checkOwner недоступность users-srv не выдается за отсутствие пользователя: клиент может повторить запрос
+func (h Handler) checkOwner(ctx context.Context, userID string) error {
+	exists, err := h.owners.Exists(ctx, userID)
+	if err != nil {
+		slog.Warn("owner check failed", slog.String("user_id", userID), slog.String("err", err.Error()))
+		return domain.Error(codes.Unavailable, grpcerr.ReasonUnavailable, "users service is unavailable")
+	}
+	if !exists {
+		return domain.Error(
+			codes.FailedPrecondition,
+			grpcerr.ReasonOwnerNotFound,
+			fmt.Sprintf("user %q not found", userID),
+			grpcerr.Resource("user", userID),
+		)
+	}
+
+	return nil
 }
 
 func (h Handler) GetLink(ctx context.Context, request *pb.GetLinkRequest) (*pb.Link, error) {
@@ -95,53 +213,66 @@ func (h Handler) GetLink(ctx context.Context, request *pb.GetLinkRequest) (*pb.L
 	// implemented
 	objectID, err := primitive.ObjectIDFromHex(request.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, domain.InvalidArgument("id", err.Error())
+	}
+
+	find := h.linksRepository.FindByID
+	if request.Deleted {
+		find = h.linksRepository.FindDeletedByID
 	}
 
-	l, err := h.linksRepository.FindByID(ctx, objectID)
+	l, err := find(ctx, objectID)
 	if err != nil {
-		if errors.Is(err, errors.New("not found")) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err, linkResource(objectID))
 	}
 
-	return &pb.Link{
-		Id:        l.ID.Hex(),
-		Title:     l.Title,
-		Url:       l.URL,
-		Images:    l.Images,
-		Tags:      l.Tags,
-		UserId:    l.UserID,
-		CreatedAt: l.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: l.UpdatedAt.Format(time.RFC3339),
-	}, nil
-}
-
-func (h Handler) UpdateLink(ctx context.Context, request *pb.UpdateLinkRequest) (*pb.Empty, error) {
+	return linkToPB(l), nil
+}
+
+func (h Handler) UpdateLink(ctx context.Context, request *pb.UpdateLinkRequest) (*pb.Link, error) {
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
 	// implemented
 	objectID, err := primitive.ObjectIDFromHex(request.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, domain.InvalidArgument("id", err.Error())
+	}
+
+	// поля вне маски не меняются, поэтому и не проверяются
+	var canonicalURL string
+	if fieldmask.Contains(request.UpdateMask, "url") {
+		if canonicalURL, err = urlnorm.Normalize(request.Url); err != nil {
+			return nil, domain.InvalidArgument("url", err.Error())
+		}
+	}
+
+	if fieldmask.Contains(request.UpdateMask, "user_id") {
+		if err := h.checkOwner(ctx, request.UserId); err != nil {
+			return nil, err
+		}
 	}
 
-	if _, err := h.linksRepository.Update(
+	// ссылку меняет только Update: PUT не создает ссылку, отсутствующая вернет NotFound
+	updated, err := h.linksRepository.Update(
 		ctx, database.UpdateLinkReq{
-			ID:     objectID,
-			URL:    request.Url,
-			Title:  request.Title,
-			Tags:   request.Tags,
-			Images: request.Images,
-			UserID: request.UserId,
+			ID:              objectID,
+			URL:             request.Url,
+			CanonicalURL:    canonicalURL,
+			Title:           request.Title,
+			Description:     request.Description,
+			Tags:            request.Tags,
+			Images:          request.Images,
+			UserID:          request.UserId,
+			ExpectedVersion: request.ExpectedVersion,
+			Fields:          request.UpdateMask.GetPaths(),
 		},
-	); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	)
+	if err != nil {
+		return nil, h.saveError(ctx, err, canonicalURL, request.UserId)
 	}
 
-	return &pb.Empty{}, nil
+	return linkToPB(updated), nil
 }
 
 func (h Handler) DeleteLink(ctx context.Context, request *pb.DeleteLinkRequest) (*pb.Empty, error) {
@@ -151,42 +282,385 @@ func (h Handler) DeleteLink(ctx context.Context, request *pb.DeleteLinkRequest)
 	// implemented
 	objectID, err := primitive.ObjectIDFromHex(request.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, domain.InvalidArgument("id", err.Error())
 	}
 
 	if err := h.linksRepository.Delete(ctx, objectID); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err, linkResource(objectID))
 	}
 
 	return &pb.Empty{}, nil
 }
 
-func (h Handler) ListLinks(ctx context.Context, request *pb.Empty) (*pb.ListLinkResponse, error) {
+// DeleteLinksByUserID переносит в корзину ссылки удаленного пользователя. Вызывается из users-srv при доставке
+// события об удалении и может повторяться
+func (h Handler) DeleteLinksByUserID(
+	ctx context.Context,
+	request *pb.DeleteLinksByUserIDRequest,
+) (*pb.DeleteLinksByUserIDResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, domain.InvalidArgument("user_id", "must not be empty")
+	}
+
+	deleted, err := h.linksRepository.DeleteByUserID(ctx, request.UserId)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.DeleteLinksByUserIDResponse{Deleted: deleted}, nil
+}
+
+// RestoreLinksByUserID возвращает из корзины ссылки, удаленные вместе с пользователем. Вызывается из users-srv при
+// доставке события о восстановлении пользователя и может повторяться
+func (h Handler) RestoreLinksByUserID(
+	ctx context.Context,
+	request *pb.RestoreLinksByUserIDRequest,
+) (*pb.RestoreLinksByUserIDResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, domain.InvalidArgument("user_id", "must not be empty")
+	}
+
+	restored, err := h.linksRepository.RestoreByUserID(ctx, request.UserId)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.RestoreLinksByUserIDResponse{Restored: restored}, nil
+}
+
+func (h Handler) RestoreLink(ctx context.Context, request *pb.RestoreLinkRequest) (*pb.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(request.Id)
+	if err != nil {
+		return nil, domain.InvalidArgument("id", err.Error())
+	}
+
+	l, err := h.linksRepository.Restore(ctx, objectID)
+	if errors.Is(err, database.ErrDuplicateURL) {
+		// пока ссылка лежала в корзине, пользователь сохранил тот же url заново
+		if trashed, findErr := h.linksRepository.FindDeletedByID(ctx, objectID); findErr == nil {
+			return nil, h.saveError(ctx, err, trashed.CanonicalURL, trashed.UserID)
+		}
+	}
+	if err != nil {
+		return nil, statusFromError(err, linkResource(objectID))
+	}
+
+	return linkToPB(l), nil
+}
+
+func (h Handler) ListTrash(ctx context.Context, request *pb.ListLinksTrashRequest) (*pb.ListLinkResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	criteria := database.FindLinkCriteria{Deleted: true}
+	if request.UserId != "" {
+		criteria.UserID = &request.UserId
+	}
+
+	list, next, err := h.linksRepository.FindPageByCriteria(
+		ctx, criteria, database.PageReq{Size: int(request.PageSize), Cursor: request.PageToken},
+	)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.ListLinkResponse{Links: linksToPB(list), NextPageToken: next}, nil
+}
+
+func (h Handler) ListLinks(ctx context.Context, request *pb.ListLinksRequest) (*pb.ListLinkResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
 	// implemented
-	list, err := h.linksRepository.FindAll(ctx)
+	list, next, err := h.linksRepository.FindAll(
+		ctx, database.PageReq{Size: int(request.PageSize), Cursor: request.PageToken},
+	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err)
 	}
 
-	response := make([]*pb.Link, 0, len(list))
+	return &pb.ListLinkResponse{Links: linksToPB(list), NextPageToken: next}, nil
+}
+
+func (h Handler) SearchLinks(ctx context.Context, request *pb.SearchLinksRequest) (*pb.SearchLinksResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
 
+	criteria := database.FindLinkCriteria{
+		Query:        strings.TrimSpace(request.Query),
+		Tags:         request.Tags,
+		TagsMatchAll: request.TagMatch == pb.TagMatch_TAG_MATCH_ALL,
+	}
+	if request.UserId != "" {
+		criteria.UserID = &request.UserId
+	}
+	switch request.Sort {
+	case pb.SortOrder_SORT_ORDER_CREATED_DESC:
+		criteria.Sort = database.SortCreatedDesc
+	case pb.SortOrder_SORT_ORDER_RELEVANCE:
+		if criteria.Query == "" {
+			return nil, domain.InvalidArgument("sort", "relevance sort requires query")
+		}
+		criteria.Sort = database.SortRelevance
+	}
+
+	var err error
+	if criteria.CreatedAfter, err = parseTime(request.CreatedAfter); err != nil {
+		return nil, domain.InvalidArgument("created_after", err.Error())
+	}
+	if criteria.CreatedBefore, err = parseTime(request.CreatedBefore); err != nil {
+		return nil, domain.InvalidArgument("created_before", err.Error())
+	}
+	if criteria.CreatedAfter != nil && criteria.CreatedBefore != nil &&
+		!criteria.CreatedAfter.Before(*criteria.CreatedBefore) {
+		return nil, domain.InvalidArgument("created_after", "must be before created_before")
+	}
+
+	list, next, err := h.linksRepository.FindPageByCriteria(
+		ctx, criteria, database.PageReq{Size: int(request.PageSize), Cursor: request.PageToken},
+	)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &pb.SearchLinksResponse{Links: linksToPB(list), NextPageToken: next}
+	if criteria.Query != "" {
+		response.Hits = searchHits(list, queryTerms(criteria.Query))
+	}
+
+	return response, nil
+}
+
+func searchHits(list []database.Link, terms map[string]struct{}) []*pb.SearchHit {
+	hits := make([]*pb.SearchHit, 0, len(list))
 	for _, l := range list {
-		response = append(
-			response, &pb.Link{
-				Id:        l.ID.Hex(),
-				Title:     l.Title,
-				Url:       l.URL,
-				Images:    l.Images,
-				Tags:      l.Tags,
-				UserId:    l.UserID,
-				CreatedAt: l.CreatedAt.Format(time.RFC3339),
-				UpdatedAt: l.UpdatedAt.Format(time.RFC3339),
+		hit := &pb.SearchHit{LinkId: l.ID.Hex(), Score: l.Score}
+		if title, ok := highlight(l.Title, terms); ok {
+			hit.Title = title
+		}
+		if url, ok := highlight(l.URL, terms); ok {
+			hit.Url = url
+		}
+		for _, tag := range l.Tags {
+			if tag, ok := highlight(tag, terms); ok {
+				hit.Tags = append(hit.Tags, tag)
+			}
+		}
+
+		hits = append(hits, hit)
+	}
+
+	return hits
+}
+
+func (h Handler) ResolveShortCode(ctx context.Context, request *pb.ResolveShortCodeRequest) (*pb.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.Code == "" {
+		return nil, domain.InvalidArgument("code", "must not be empty")
+	}
+
+	l, err := h.linksRepository.FindByShortCode(ctx, request.Code)
+	if err != nil {
+		return nil, statusFromError(err, grpcerr.Resource("short_code", request.Code))
+	}
+
+	if v := request.Visit; v != nil {
+		if !h.visits.Record(
+			visits.Visit{
+				LinkID:    l.ID,
+				VisitedAt: time.Now(),
+				Referrer:  v.Referrer,
+				UserAgent: v.UserAgent,
+				ClientIP:  v.ClientIp,
+			},
+		) {
+			slog.Warn("visits queue is full, visit dropped", slog.String("link_id", l.ID.Hex()))
+		}
+	}
+
+	return linkToPB(l), nil
+}
+
+func (h Handler) ListBrokenLinks(
+	ctx context.Context,
+	request *pb.ListBrokenLinksRequest,
+) (*pb.ListLinkResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	criteria := database.FindLinkCriteria{MinFailures: h.brokenAfter}
+	if request.UserId != "" {
+		criteria.UserID = &request.UserId
+	}
+
+	list, next, err := h.linksRepository.FindPageByCriteria(
+		ctx, criteria, database.PageReq{Size: int(request.PageSize), Cursor: request.PageToken},
+	)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.ListLinkResponse{Links: linksToPB(list), NextPageToken: next}, nil
+}
+
+func (h Handler) GetLinkStats(ctx context.Context, request *pb.GetLinkStatsRequest) (*pb.LinkStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(request.LinkId)
+	if err != nil {
+		return nil, domain.InvalidArgument("link_id", err.Error())
+	}
+
+	criteria := database.FindVisitsCriteria{LinkID: objectID}
+	if criteria.VisitedAfter, err = parseTime(request.From); err != nil {
+		return nil, domain.InvalidArgument("from", err.Error())
+	}
+	if criteria.VisitedBefore, err = parseTime(request.To); err != nil {
+		return nil, domain.InvalidArgument("to", err.Error())
+	}
+	if criteria.VisitedAfter != nil && criteria.VisitedBefore != nil &&
+		!criteria.VisitedAfter.Before(*criteria.VisitedBefore) {
+		return nil, domain.InvalidArgument("from", "must be before to")
+	}
+
+	// у удаленной ссылки могли остаться переходы, но статистику отдаем только по существующим
+	if _, err := h.linksRepository.FindByID(ctx, objectID); err != nil {
+		return nil, statusFromError(err, linkResource(objectID))
+	}
+
+	stats, err := h.linksRepository.VisitStats(ctx, criteria)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &pb.LinkStats{
+		LinkId:         request.LinkId,
+		TotalVisits:    stats.Total,
+		UniqueVisitors: stats.UniqueVisitors,
+		Daily:          make([]*pb.DailyVisits, 0, len(stats.Daily)),
+	}
+	for _, d := range stats.Daily {
+		response.Daily = append(
+			response.Daily, &pb.DailyVisits{
+				Date:           d.Day.Format(time.DateOnly),
+				Visits:         d.Visits,
+				UniqueVisitors: d.UniqueVisitors,
 			},
 		)
 	}
 
-	return &pb.ListLinkResponse{Links: response}, nil
+	return response, nil
+}
+
+// withShortCode вызывает save с пользовательским кодом alias или, если он пустой, со сгенерированным. Занятый
+// сгенерированный код заменяется новым, пока не кончатся попытки. Если заняты все, ошибка уже не ErrShortCodeTaken:
+// клиент код не выбирал и AlreadyExists его бы только запутал, это Internal
+func (h Handler) withShortCode(alias string, save func(code string) error) error {
+	if alias != "" {
+		return save(alias)
+	}
+
+	var err error
+	for i := 0; i < h.shortCodeAttempts; i++ {
+		var code string
+		if code, err = h.shortCodes.Generate(); err != nil {
+			return err
+		}
+
+		if err = save(code); !errors.Is(err, database.ErrShortCodeTaken) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("no free short code after %d attempts: %v", h.shortCodeAttempts, err) //nolint:errorlint
+}
+
+// saveError переводит ошибку сохранения в статус. При дубликате url в ответ добавляется идентификатор уже
+// сохраненной ссылки
+func (h Handler) saveError(ctx context.Context, err error, canonicalURL, userID string) error {
+	if !errors.Is(err, database.ErrDuplicateURL) {
+		return statusFromError(err)
+	}
+
+	existing, findErr := h.linksRepository.FindByUserAndURL(ctx, canonicalURL, userID)
+	if findErr != nil {
+		return statusFromError(err)
+	}
+
+	return duplicateURLStatus(existing.ID)
+}
+
+// parseTime пустая строка означает отсутствие границы
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func linkToPB(l database.Link) *pb.Link {
+	return &pb.Link{
+		Id:          l.ID.Hex(),
+		Title:       l.Title,
+		Description: l.Description,
+		Url:         l.URL,
+		Images:      l.Images,
+		Tags:        l.Tags,
+		UserId:      l.UserID,
+		ShortCode:   l.ShortCode,
+		CreatedAt:   l.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   l.UpdatedAt.Format(time.RFC3339),
+		Health:      healthToPB(l.Health),
+		DeletedAt:   formatOptionalTime(l.DeletedAt),
+		Version:     l.Version,
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func healthToPB(h *database.LinkHealth) *pb.LinkHealth {
+	if h == nil {
+		return nil
+	}
+
+	return &pb.LinkHealth{
+		StatusCode:    int32(h.StatusCode),
+		Error:         h.Error,
+		RedirectUrl:   h.RedirectURL,
+		CheckedAt:     h.CheckedAt.Format(time.RFC3339),
+		FailureStreak: int32(h.FailureStreak),
+	}
+}
+
+func linksToPB(list []database.Link) []*pb.Link {
+	response := make([]*pb.Link, 0, len(list))
+	for _, l := range list {
+		response = append(response, linkToPB(l))
+	}
+
+	return response
 }
@@ -0,0 +1,431 @@
+package linkgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/grpcerr"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/link/visits"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+type stubRepository struct {
+	linksRepository
+	taken   map[string]struct{}
+	created []database.CreateLinkReq
+}
+
+func (r *stubRepository) Create(_ context.Context, req database.CreateLinkReq) (database.Link, error) {
+	if _, ok := r.taken[req.ShortCode]; ok {
+		return database.Link{}, fmt.Errorf("mongo InsertOne: %w", database.ErrShortCodeTaken)
+	}
+	if _, err := r.FindByUserAndURL(context.Background(), req.CanonicalURL, req.UserID); err == nil {
+		return database.Link{}, fmt.Errorf("mongo InsertOne: %w", database.ErrDuplicateURL)
+	}
+	r.created = append(r.created, req)
+
+	return database.Link{ID: req.ID, ShortCode: req.ShortCode}, nil
+}
+
+func (r *stubRepository) FindByShortCode(_ context.Context, code string) (database.Link, error) {
+	for _, req := range r.created {
+		if req.ShortCode == code {
+			return database.Link{ID: req.ID, URL: req.URL, ShortCode: req.ShortCode}, nil
+		}
+	}
+
+	return database.Link{}, fmt.Errorf("mongo FindOne: %w", database.ErrNotFound)
+}
+
+func (r *stubRepository) FindByUserAndURL(_ context.Context, canonicalURL, userID string) (database.Link, error) {
+	for _, req := range r.created {
+		if req.CanonicalURL == canonicalURL && req.UserID == userID {
+			return database.Link{ID: req.ID, URL: req.URL, CanonicalURL: req.CanonicalURL}, nil
+		}
+	}
+
+	return database.Link{}, fmt.Errorf("mongo FindOne: %w", database.ErrNotFound)
+}
+
+type stubRecorder struct {
+	visits []visits.Visit
+}
+
+func (r *stubRecorder) Record(v visits.Visit) bool {
+	r.visits = append(r.visits, v)
+
+	return true
+}
+
+type stubQueue struct {
+	queued []primitive.ObjectID
+}
+
+func (q *stubQueue) Enqueue(linkID primitive.ObjectID, _ string) bool {
+	q.queued = append(q.queued, linkID)
+
+	return true
+}
+
+type stubGenerator struct {
+	codes []string
+}
+
+func (g *stubGenerator) Generate() (string, error) {
+	code := g.codes[0]
+	g.codes = g.codes[1:]
+
+	return code, nil
+}
+
+// stubOwners считает существующими всех пользователей, кроме unknown. Пользователь down вызывает ошибку users-srv
+type stubOwners struct{}
+
+func (stubOwners) Exists(_ context.Context, userID string) (bool, error) {
+	switch userID {
+	case "unknown":
+		return false, nil
+	case "down":
+		return false, errors.New("users GetUser: connection refused")
+	}
+
+	return true, nil
+}
+
+func TestHandler_CreateLinkShortCode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := &stubRepository{taken: map[string]struct{}{"taken1": {}, "taken2": {}, "vanity": {}}}
+	h := New(
+		repo, Params{
+			ShortCodes:        &stubGenerator{codes: []string{"taken1", "taken2", "free", "unused"}},
+			ShortCodeAttempts: 3,
+			Metadata:          &stubQueue{},
+			Owners:            stubOwners{},
+			BrokenAfter:       3,
+			Timeout:           time.Second,
+		},
+	)
+
+	_, err := h.CreateLink(ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru"})
+	require.NoError(t, err)
+	require.Len(t, repo.created, 1)
+	require.Equal(t, "free", repo.created[0].ShortCode)
+
+	_, err = h.CreateLink(
+		ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru", ShortCode: "vanity"},
+	)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = h.CreateLink(
+		ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru", ShortCode: "a b"},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	exhausted := New(
+		repo, Params{
+			ShortCodes:        &stubGenerator{codes: []string{"taken1", "taken2"}},
+			ShortCodeAttempts: 2,
+			Metadata:          &stubQueue{},
+			Owners:            stubOwners{},
+			BrokenAfter:       3,
+			Timeout:           time.Second,
+		},
+	)
+	_, err = exhausted.CreateLink(ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru"})
+	require.Equal(t, codes.Internal, status.Code(err), "клиент не выбирал код, занятость кодов не его ошибка")
+}
+
+func TestHandler_ResolveShortCodeRecordsVisit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := primitive.NewObjectID()
+	repo := &stubRepository{created: []database.CreateLinkReq{{ID: id, URL: "https://ya.ru", ShortCode: "abc"}}}
+	recorder := &stubRecorder{}
+	h := New(
+		repo, Params{
+			ShortCodes:        &stubGenerator{},
+			ShortCodeAttempts: 1,
+			Visits:            recorder,
+			Metadata:          &stubQueue{},
+			Owners:            stubOwners{},
+			BrokenAfter:       3,
+			Timeout:           time.Second,
+		},
+	)
+
+	_, err := h.ResolveShortCode(ctx, &pb.ResolveShortCodeRequest{Code: "abc"})
+	require.NoError(t, err)
+	require.Empty(t, recorder.visits)
+
+	link, err := h.ResolveShortCode(
+		ctx, &pb.ResolveShortCodeRequest{
+			Code:  "abc",
+			Visit: &pb.Visit{Referrer: "https://google.com", UserAgent: "curl", ClientIp: "10.0.0.1"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "https://ya.ru", link.Url)
+	require.Len(t, recorder.visits, 1)
+	require.Equal(t, id, recorder.visits[0].LinkID)
+	require.Equal(t, "10.0.0.1", recorder.visits[0].ClientIP)
+
+	_, err = h.ResolveShortCode(ctx, &pb.ResolveShortCodeRequest{Code: "missing", Visit: &pb.Visit{}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Len(t, recorder.visits, 1)
+}
+
+func TestHandler_CreateLinkQueuesMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	queue := &stubQueue{}
+	h := New(
+		&stubRepository{}, Params{
+			ShortCodes:        &stubGenerator{codes: []string{"a1", "a2"}},
+			ShortCodeAttempts: 1,
+			Metadata:          queue,
+			Owners:            stubOwners{},
+			BrokenAfter:       3,
+			Timeout:           time.Second,
+		},
+	)
+
+	id := primitive.NewObjectID()
+	_, err := h.CreateLink(ctx, &pb.CreateLinkRequest{Id: id.Hex(), Url: "https://ya.ru", Title: "ya"})
+	require.NoError(t, err)
+	require.Equal(t, []primitive.ObjectID{id}, queue.queued)
+
+	// все поля заданы пользователем, скачивать страницу незачем
+	_, err = h.CreateLink(
+		ctx, &pb.CreateLinkRequest{
+			Id:          primitive.NewObjectID().Hex(),
+			Url:         "https://ya.ru/search",
+			Title:       "ya",
+			Description: "search",
+			Images:      []string{"https://ya.ru/logo.png"},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, queue.queued, 1)
+}
+
+func TestHandler_CreateLinkGeneratesID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := &stubRepository{}
+	h := New(
+		repo, Params{
+			ShortCodes:        &stubGenerator{codes: []string{"a1", "a2"}},
+			ShortCodeAttempts: 1,
+			Metadata:          &stubQueue{},
+			Owners:            stubOwners{},
+			BrokenAfter:       3,
+			Timeout:           time.Second,
+		},
+	)
+
+	resp, err := h.CreateLink(ctx, &pb.CreateLinkRequest{Url: "https://ya.ru", Title: "ya"})
+	require.NoError(t, err)
+	require.Equal(t, repo.created[0].ID.Hex(), resp.Id)
+	require.Equal(t, "a1", resp.ShortCode, "в ответе сохраненная ссылка, а не запрос")
+	require.False(t, repo.created[0].ID.IsZero())
+
+	// идентификатор от клиента сохраняется, например при импорте
+	id := primitive.NewObjectID()
+	resp, err = h.CreateLink(ctx, &pb.CreateLinkRequest{Id: id.Hex(), Url: "https://ya.ru/search"})
+	require.NoError(t, err)
+	require.Equal(t, id.Hex(), resp.Id)
+}
+
+func TestHandler_CreateLinkDuplicateURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := &stubRepository{}
+	h := New(
+		repo, Params{
+			ShortCodes:        &stubGenerator{codes: []string{"a", "b", "c"}},
+			ShortCodeAttempts: 1,
+			Metadata:          &stubQueue{},
+			Owners:            stubOwners{},
+			BrokenAfter:       3,
+			Timeout:           time.Second,
+		},
+	)
+
+	first := primitive.NewObjectID()
+	_, err := h.CreateLink(
+		ctx, &pb.CreateLinkRequest{Id: first.Hex(), Url: "https://Ya.ru/?utm_source=mail", UserId: "user"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "https://ya.ru/", repo.created[0].CanonicalURL)
+	require.Equal(t, "https://Ya.ru/?utm_source=mail", repo.created[0].URL)
+
+	_, err = h.CreateLink(
+		ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru:443#top", UserId: "user"},
+	)
+	st := status.Convert(err)
+	require.Equal(t, codes.AlreadyExists, st.Code())
+	require.Len(t, st.Details(), 2)
+	require.Equal(t, first.Hex(), st.Details()[0].(*errdetails.ResourceInfo).ResourceName)
+	require.Equal(t, grpcerr.ReasonDuplicateURL, st.Details()[1].(*errdetails.ErrorInfo).Reason)
+
+	// у другого пользователя та же страница не считается дубликатом
+	_, err = h.CreateLink(
+		ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru", UserId: "other"},
+	)
+	require.NoError(t, err)
+
+	_, err = h.CreateLink(ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "ya.ru", UserId: "user"})
+	st = status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	violations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+	require.Len(t, violations, 1)
+	require.Equal(t, "url", violations[0].Field)
+}
+
+type trashRepository struct {
+	stubRepository
+	trashed database.Link
+}
+
+func (r *trashRepository) Restore(_ context.Context, id primitive.ObjectID) (database.Link, error) {
+	if id != r.trashed.ID {
+		return database.Link{}, fmt.Errorf("mongo FindOneAndUpdate: %w", database.ErrNotFound)
+	}
+	if _, err := r.FindByUserAndURL(context.Background(), r.trashed.CanonicalURL, r.trashed.UserID); err == nil {
+		return database.Link{}, fmt.Errorf("mongo FindOneAndUpdate: %w", database.ErrDuplicateURL)
+	}
+
+	return r.trashed, nil
+}
+
+func (r *trashRepository) FindDeletedByID(_ context.Context, id primitive.ObjectID) (database.Link, error) {
+	if id != r.trashed.ID {
+		return database.Link{}, fmt.Errorf("mongo FindOne: %w", database.ErrNotFound)
+	}
+
+	return r.trashed, nil
+}
+
+func TestHandler_CheckOwner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := &stubRepository{}
+	h := New(
+		repo, Params{
+			ShortCodes:        &stubGenerator{codes: []string{"a", "b"}},
+			ShortCodeAttempts: 1,
+			Metadata:          &stubQueue{},
+			Owners:            stubOwners{},
+			BrokenAfter:       3,
+			Timeout:           time.Second,
+		},
+	)
+
+	_, err := h.CreateLink(
+		ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru", UserId: "unknown"},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = h.CreateLink(
+		ctx, &pb.CreateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru", UserId: "down"},
+	)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = h.UpdateLink(
+		ctx, &pb.UpdateLinkRequest{Id: primitive.NewObjectID().Hex(), Url: "https://ya.ru", UserId: "unknown"},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Empty(t, repo.created)
+}
+
+func TestHandler_RestoreLink(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	deletedAt := time.Now()
+	repo := &trashRepository{
+		trashed: database.Link{
+			ID:           primitive.NewObjectID(),
+			URL:          "https://ya.ru",
+			CanonicalURL: "https://ya.ru/",
+			UserID:       "user",
+			DeletedAt:    &deletedAt,
+		},
+	}
+	h := New(
+		repo, Params{
+			ShortCodes:        &stubGenerator{codes: []string{"a"}},
+			ShortCodeAttempts: 1,
+			Metadata:          &stubQueue{},
+			Owners:            stubOwners{},
+			BrokenAfter:       3,
+			Timeout:           time.Second,
+		},
+	)
+
+	trashed, err := h.GetLink(ctx, &pb.GetLinkRequest{Id: repo.trashed.ID.Hex(), Deleted: true})
+	require.NoError(t, err)
+	require.Equal(t, "user", trashed.UserId)
+	require.NotEmpty(t, trashed.DeletedAt)
+
+	_, err = h.RestoreLink(ctx, &pb.RestoreLinkRequest{Id: primitive.NewObjectID().Hex()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// пока ссылка была в корзине, пользователь сохранил тот же url заново
+	again := primitive.NewObjectID()
+	_, err = h.CreateLink(ctx, &pb.CreateLinkRequest{Id: again.Hex(), Url: "https://ya.ru/", UserId: "user"})
+	require.NoError(t, err)
+
+	_, err = h.RestoreLink(ctx, &pb.RestoreLinkRequest{Id: repo.trashed.ID.Hex()})
+	st := status.Convert(err)
+	require.Equal(t, codes.AlreadyExists, st.Code())
+	require.Equal(t, again.Hex(), st.Details()[0].(*errdetails.ResourceInfo).ResourceName)
+}
+
+func TestHandler_GetLinksSummaryValidates(t *testing.T) {
+	t.Parallel()
+
+	h := New(&stubRepository{}, Params{Timeout: time.Second})
+	for name, req := range map[string]*pb.GetLinksSummaryRequest{
+		"no user":        {Recent: 5},
+		"negative":       {UserId: "u", Recent: -1},
+		"too many tags":  {UserId: "u", TopTags: maxSummaryItems + 1},
+		"too many links": {UserId: "u", Recent: maxSummaryItems + 1},
+	} {
+		_, err := h.GetLinksSummary(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+}
+
+func TestStatusFromError(t *testing.T) {
+	t.Parallel()
+
+	id := primitive.NewObjectID()
+	st := status.Convert(statusFromError(fmt.Errorf("mongo FindOne: %w", database.ErrNotFound), linkResource(id)))
+	require.Equal(t, codes.NotFound, st.Code())
+	require.NotContains(t, st.Message(), "mongo")
+	require.Equal(t, id.Hex(), st.Details()[0].(*errdetails.ResourceInfo).ResourceName)
+	require.Equal(t, string(domain), st.Details()[1].(*errdetails.ErrorInfo).Domain)
+
+	st = status.Convert(statusFromError(errors.New("mongo FindOne: connection refused")))
+	require.Equal(t, codes.Internal, st.Code())
+	require.Equal(t, "internal error", st.Message())
+	require.Equal(t, grpcerr.ReasonInternal, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+}
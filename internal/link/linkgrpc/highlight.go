@@ -0,0 +1,80 @@
+package linkgrpc
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	markOpen  = "<mark>"
+	markClose = "</mark>"
+)
+
+// queryTerms разбирает строку $text поиска Mongo на слова в нижнем регистре. Фразы в кавычках разбиваются на
+// отдельные слова, исключенные через минус слова не подсвечиваются
+func queryTerms(query string) map[string]struct{} {
+	terms := make(map[string]struct{})
+
+	for i, part := range strings.Split(query, `"`) {
+		inPhrase := i%2 == 1
+		for _, field := range strings.Fields(part) {
+			if !inPhrase && strings.HasPrefix(field, "-") {
+				continue
+			}
+			for _, word := range words(field) {
+				terms[strings.ToLower(word)] = struct{}{}
+			}
+		}
+	}
+
+	return terms
+}
+
+// highlight экранирует text как HTML и оборачивает в <mark> слова, совпавшие с terms. Второй результат false, если
+// совпадений нет
+func highlight(text string, terms map[string]struct{}) (string, bool) {
+	var (
+		b       strings.Builder
+		matched bool
+		start   = -1
+	)
+
+	flush := func(end int) {
+		word := text[start:end]
+		if _, ok := terms[strings.ToLower(word)]; ok {
+			matched = true
+			b.WriteString(markOpen + html.EscapeString(word) + markClose)
+		} else {
+			b.WriteString(html.EscapeString(word))
+		}
+		start = -1
+	}
+
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			flush(i)
+		}
+		b.WriteString(html.EscapeString(string(r)))
+	}
+	if start >= 0 {
+		flush(len(text))
+	}
+
+	return b.String(), matched
+}
+
+// words делит строку на слова так же, как текстовый индекс Mongo: разделителями считаются пробелы и пунктуация
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return !isWordRune(r) })
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
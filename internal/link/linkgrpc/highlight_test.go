@@ -0,0 +1,67 @@
+package linkgrpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryTerms(t *testing.T) {
+	t.Parallel()
+
+	terms := queryTerms(`Golang "Clean Code" -java ya.ru`)
+
+	require.Equal(
+		t, map[string]struct{}{"golang": {}, "clean": {}, "code": {}, "ya": {}, "ru": {}}, terms,
+	)
+}
+
+func TestHighlight(t *testing.T) {
+	t.Parallel()
+
+	terms := queryTerms("go ya")
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+		matched  bool
+	}{
+		{
+			name:     "title",
+			text:     "Go & Rust",
+			expected: "<mark>Go</mark> &amp; Rust",
+			matched:  true,
+		},
+		{
+			name:     "url",
+			text:     "https://ya.ru/?q=<go>",
+			expected: "https://<mark>ya</mark>.ru/?q=&lt;<mark>go</mark>&gt;",
+			matched:  true,
+		},
+		{
+			name:     "whole words only",
+			text:     "golang",
+			expected: "golang",
+		},
+		{
+			name:     "cyrillic",
+			text:     "Язык Go",
+			expected: "Язык <mark>Go</mark>",
+			matched:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				got, matched := highlight(tc.text, terms)
+				require.Equal(t, tc.expected, got)
+				require.Equal(t, tc.matched, matched)
+			},
+		)
+	}
+}
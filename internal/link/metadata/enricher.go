@@ -0,0 +1,87 @@
+package metadata
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+type linksRepository interface {
+	ApplyMetadata(ctx context.Context, id primitive.ObjectID, meta database.LinkMetadata) error
+}
+
+type fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (Metadata, error)
+}
+
+type job struct {
+	linkID primitive.ObjectID
+	url    string
+}
+
+// Enricher дополняет созданные ссылки метаданными страницы в фоне, чтобы CreateLink не ждал чужой сайт
+type Enricher struct {
+	fetcher fetcher
+	repo    linksRepository
+	workers int
+	queue   chan job
+}
+
+func NewEnricher(fetcher fetcher, repo linksRepository, workers, bufferSize int) *Enricher {
+	return &Enricher{fetcher: fetcher, repo: repo, workers: workers, queue: make(chan job, bufferSize)}
+}
+
+// Enqueue ставит ссылку в очередь без ожидания. При переполненной очереди возвращает false, ссылка остается без
+// метаданных
+func (e *Enricher) Enqueue(linkID primitive.ObjectID, url string) bool {
+	select {
+	case e.queue <- job{linkID: linkID, url: url}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run обрабатывает очередь в workers горутинах до отмены ctx. Необработанные ссылки при остановке теряются
+func (e *Enricher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < e.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case j := <-e.queue:
+					e.enrich(ctx, j)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (e *Enricher) enrich(ctx context.Context, j job) {
+	meta, err := e.fetcher.Fetch(ctx, j.url)
+	if err != nil {
+		slog.Info("metadata Fetch", slog.String("link_id", j.linkID.Hex()), slog.Any("err", err))
+		return
+	}
+
+	if err := e.repo.ApplyMetadata(
+		ctx, j.linkID, database.LinkMetadata{
+			Title:       meta.Title,
+			Description: meta.Description,
+			Images:      meta.Images,
+		},
+	); err != nil {
+		slog.Error("links ApplyMetadata", slog.String("link_id", j.linkID.Hex()), slog.Any("err", err))
+	}
+}
@@ -0,0 +1,78 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+type stubFetcher map[string]Metadata
+
+func (f stubFetcher) Fetch(_ context.Context, rawURL string) (Metadata, error) {
+	m, ok := f[rawURL]
+	if !ok {
+		return m, errors.New("not found")
+	}
+
+	return m, nil
+}
+
+type stubRepository struct {
+	mu      sync.Mutex
+	applied map[primitive.ObjectID]database.LinkMetadata
+}
+
+func (r *stubRepository) ApplyMetadata(_ context.Context, id primitive.ObjectID, meta database.LinkMetadata) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.applied[id] = meta
+
+	return nil
+}
+
+func (r *stubRepository) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.applied)
+}
+
+func TestEnricher_Run(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepository{applied: make(map[primitive.ObjectID]database.LinkMetadata)}
+	fetcher := stubFetcher{
+		"https://a.example": {Title: "a", Images: []string{"https://a.example/a.png"}},
+		"https://b.example": {Description: "b"},
+	}
+	e := NewEnricher(fetcher, repo, 2, 10)
+
+	a, b, broken := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	require.True(t, e.Enqueue(a, "https://a.example"))
+	require.True(t, e.Enqueue(broken, "https://broken.example"))
+	require.True(t, e.Enqueue(b, "https://b.example"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		e.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool { return repo.count() == 2 }, time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	require.Equal(t, "a", repo.applied[a].Title)
+	require.Equal(t, []string{"https://a.example/a.png"}, repo.applied[a].Images)
+	require.Equal(t, "b", repo.applied[b].Description)
+	require.NotContains(t, repo.applied, broken)
+}
@@ -0,0 +1,272 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const maxRedirects = 5
+
+var (
+	ErrUnsupportedURL     = errors.New("unsupported url")
+	ErrUnexpectedResponse = errors.New("unexpected response")
+	errPrivateAddress     = errors.New("address is not public")
+)
+
+type httpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type Metadata struct {
+	Title       string
+	Description string
+	Images      []string // абсолютные адреса
+}
+
+// Fetcher скачивает страницу и достает из head заголовок, описание и картинки. Читается не больше maxBytes байт,
+// метаданные почти всегда в начале документа
+type Fetcher struct {
+	client    httpClient
+	timeout   time.Duration
+	maxBytes  int64
+	maxImages int
+}
+
+func NewFetcher(client httpClient, timeout time.Duration, maxBytes int64, maxImages int) *Fetcher {
+	return &Fetcher{client: client, timeout: timeout, maxBytes: maxBytes, maxImages: maxImages}
+}
+
+// NewHTTPClient клиент для запросов по url ссылок, который ходит только на публичные адреса: url задают
+// пользователи, и без этой проверки через links-srv можно было бы опрашивать внутреннюю сеть
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !isPublic(ip) {
+				return fmt.Errorf("%w: %s", errPrivateAddress, host)
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       time.Minute,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("%w: redirect to %s", ErrUnsupportedURL, req.URL.Scheme)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (Metadata, error) {
+	var m Metadata
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return m, fmt.Errorf("%w: %q", ErrUnsupportedURL, rawURL)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return m, fmt.Errorf("http NewRequest: %w", err)
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return m, fmt.Errorf("http Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return m, fmt.Errorf("%w: status %d", ErrUnexpectedResponse, resp.StatusCode)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != "text/html" &&
+		mediaType != "application/xhtml+xml" {
+		return m, fmt.Errorf("%w: content type %q", ErrUnexpectedResponse, mediaType)
+	}
+
+	// после редиректов относительные адреса картинок считаются от итоговой страницы
+	base := u
+	if resp.Request != nil && resp.Request.URL != nil {
+		base = resp.Request.URL
+	}
+
+	return parse(io.LimitReader(resp.Body, f.maxBytes), base, f.maxImages), nil
+}
+
+// parse собирает теги до конца head. Приоритет: OpenGraph, затем Twitter Card, затем <title> и meta description
+func parse(r io.Reader, base *url.URL, maxImages int) Metadata {
+	var (
+		title, ogTitle, twitterTitle string
+		desc, ogDesc, twitterDesc    string
+		ogImages, twitterImages      []string
+	)
+
+	z := html.NewTokenizer(r)
+	for done := false; !done; {
+		switch z.Next() {
+		case html.ErrorToken:
+			done = true
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			switch tok.DataAtom {
+			case atom.Body:
+				done = true
+			case atom.Title:
+				if title == "" && z.Next() == html.TextToken {
+					title = strings.TrimSpace(html.UnescapeString(string(z.Text())))
+				}
+			case atom.Meta:
+				key, content := metaAttrs(tok)
+				switch key {
+				case "og:title":
+					ogTitle = firstNonEmpty(ogTitle, content)
+				case "twitter:title":
+					twitterTitle = firstNonEmpty(twitterTitle, content)
+				case "og:description":
+					ogDesc = firstNonEmpty(ogDesc, content)
+				case "twitter:description":
+					twitterDesc = firstNonEmpty(twitterDesc, content)
+				case "description":
+					desc = firstNonEmpty(desc, content)
+				case "og:image", "og:image:url", "og:image:secure_url":
+					ogImages = appendImage(ogImages, content, base)
+				case "twitter:image", "twitter:image:src":
+					twitterImages = appendImage(twitterImages, content, base)
+				}
+			}
+		case html.EndTagToken:
+			if z.Token().DataAtom == atom.Head {
+				done = true
+			}
+		}
+	}
+
+	images := ogImages
+	if len(images) == 0 {
+		images = twitterImages
+	}
+	if len(images) > maxImages {
+		images = images[:maxImages]
+	}
+
+	return Metadata{
+		Title:       firstNonEmpty(ogTitle, twitterTitle, title),
+		Description: firstNonEmpty(ogDesc, twitterDesc, desc),
+		Images:      images,
+	}
+}
+
+// metaAttrs OpenGraph задает ключ в property, Twitter Card и description в name
+func metaAttrs(tok html.Token) (string, string) {
+	var key, content string
+	for _, attr := range tok.Attr {
+		switch attr.Key {
+		case "property", "name":
+			if key == "" {
+				key = strings.ToLower(strings.TrimSpace(attr.Val))
+			}
+		case "content":
+			content = strings.TrimSpace(attr.Val)
+		}
+	}
+
+	return key, content
+}
+
+func appendImage(images []string, value string, base *url.URL) []string {
+	ref, err := url.Parse(value)
+	if value == "" || err != nil {
+		return images
+	}
+
+	abs := base.ResolveReference(ref)
+	if abs.Scheme != "http" && abs.Scheme != "https" {
+		return images
+	}
+
+	for _, image := range images {
+		if image == abs.String() {
+			return images
+		}
+	}
+
+	return append(images, abs.String())
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// nonPublicNets диапазоны, которых нет в проверках net.IP: вся сеть 0.0.0.0/8 ("этот хост", на Linux 0.0.0.0
+// ведет на localhost) и адреса CGNAT, которые у облачных провайдеров бывают внутренними
+var nonPublicNets = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+}
+
+func isPublic(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, n := range nonPublicNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	return n
+}
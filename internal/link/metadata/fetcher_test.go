@@ -0,0 +1,167 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const page = `<!doctype html>
+<html>
+<head>
+  <title> Обычный заголовок </title>
+  <meta name="description" content="meta description">
+  <meta name="twitter:title" content="twitter title">
+  <meta property="og:title" content="OG &amp; title">
+  <meta property="og:image" content="/img/a.png">
+  <meta property="og:image" content="https://cdn.example.com/b.png">
+  <meta property="og:image" content="/img/a.png">
+  <meta name="twitter:image" content="/img/twitter.png">
+</head>
+<body><meta property="og:description" content="ignored, outside head"></body>
+</html>`
+
+func TestFetcher_Fetch(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"/page", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(page))
+		},
+	)
+	mux.HandleFunc(
+		"/moved", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/articles/page", http.StatusFound)
+		},
+	)
+	mux.HandleFunc(
+		"/articles/page", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte(`<head><title>t</title><meta name="twitter:image" content="img.png"></head>`))
+		},
+	)
+	mux.HandleFunc(
+		"/json", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		},
+	)
+	mux.HandleFunc(
+		"/missing", func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		},
+	)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	f := NewFetcher(server.Client(), time.Second, 1<<20, 5)
+
+	m, err := f.Fetch(ctx, server.URL+"/page")
+	require.NoError(t, err)
+	require.Equal(t, "OG & title", m.Title)
+	require.Equal(t, "meta description", m.Description)
+	require.Equal(t, []string{server.URL + "/img/a.png", "https://cdn.example.com/b.png"}, m.Images)
+
+	m, err = f.Fetch(ctx, server.URL+"/moved")
+	require.NoError(t, err)
+	require.Equal(t, "t", m.Title)
+	require.Equal(t, []string{server.URL + "/articles/img.png"}, m.Images)
+
+	_, err = f.Fetch(ctx, server.URL+"/json")
+	require.True(t, errors.Is(err, ErrUnexpectedResponse))
+
+	_, err = f.Fetch(ctx, server.URL+"/missing")
+	require.True(t, errors.Is(err, ErrUnexpectedResponse))
+
+	_, err = f.Fetch(ctx, "ftp://example.com/file")
+	require.True(t, errors.Is(err, ErrUnsupportedURL))
+}
+
+func TestFetcher_FetchLimits(t *testing.T) {
+	t.Parallel()
+
+	slow := make(chan struct{})
+	t.Cleanup(func() { close(slow) })
+
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				if r.URL.Path == "/slow" {
+					select {
+					case <-slow:
+					case <-r.Context().Done():
+					}
+					return
+				}
+
+				// заголовок после лимита не читается
+				_, _ = w.Write([]byte("<head>" + strings.Repeat("<meta>", 1000) + "<title>late</title></head>"))
+			},
+		),
+	)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+
+	m, err := NewFetcher(server.Client(), time.Second, 512, 5).Fetch(ctx, server.URL+"/big")
+	require.NoError(t, err)
+	require.Empty(t, m.Title)
+
+	_, err = NewFetcher(server.Client(), 50*time.Millisecond, 512, 5).Fetch(ctx, server.URL+"/slow")
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestNewHTTPClient_RejectsPrivateAddresses(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				_, _ = w.Write([]byte(page))
+			},
+		),
+	)
+	t.Cleanup(server.Close)
+
+	f := NewFetcher(NewHTTPClient(time.Second), time.Second, 1<<20, 5)
+
+	_, err := f.Fetch(context.Background(), server.URL)
+	require.True(t, errors.Is(err, errPrivateAddress))
+}
+
+func TestIsPublic(t *testing.T) {
+	t.Parallel()
+
+	for ip, public := range map[string]bool{
+		"93.158.134.3":      true,
+		"100.63.255.255":    true,
+		"100.128.0.0":       true,
+		"2a02:6b8::2:242":   true,
+		"127.0.0.1":         false,
+		"10.1.2.3":          false,
+		"192.168.0.1":       false,
+		"169.254.169.254":   false,
+		"0.0.0.0":           false,
+		"0.1.2.3":           false,
+		"100.64.0.1":        false,
+		"100.127.255.254":   false,
+		"::ffff:100.64.0.1": false,
+		"::1":               false,
+		"fd00::1":           false,
+		"::ffff:0.0.0.1":    false,
+	} {
+		require.Equal(t, public, isPublic(net.ParseIP(ip)), ip)
+	}
+}
@@ -0,0 +1,129 @@
+package owners
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+type usersClient interface {
+	GetUser(ctx context.Context, in *pb.GetUserRequest, opts ...grpc.CallOption) (*pb.User, error)
+}
+
+type Params struct {
+	Disabled   bool          // не проверять владельцев, для запуска без users-srv и тестов
+	CacheTTL   time.Duration // сколько помнить ответ users-srv, в том числе отрицательный
+	MaxEntries int           // при переполнении кеш очищается
+	Timeout    time.Duration // на запрос в users-srv
+}
+
+type entry struct {
+	exists    bool
+	expiresAt time.Time
+}
+
+// Validator проверяет, что владелец ссылки существует в users-srv. Ответы кешируются на CacheTTL, а одновременные
+// запросы про одного пользователя объединяются в один, чтобы массовая вставка не нагружала users-srv
+type Validator struct {
+	client     usersClient
+	disabled   bool
+	ttl        time.Duration
+	maxEntries int
+	timeout    time.Duration
+
+	group singleflight.Group
+	mu    sync.Mutex
+	cache map[string]entry
+}
+
+func New(client usersClient, params Params) *Validator {
+	return &Validator{
+		client:     client,
+		disabled:   params.Disabled,
+		ttl:        params.CacheTTL,
+		maxEntries: params.MaxEntries,
+		timeout:    params.Timeout,
+		cache:      make(map[string]entry),
+	}
+}
+
+// Exists ошибка означает, что users-srv не ответил, и ничего не говорит о пользователе
+func (v *Validator) Exists(ctx context.Context, userID string) (bool, error) {
+	if v.disabled {
+		return true, nil
+	}
+
+	if exists, ok := v.cached(userID); ok {
+		return exists, nil
+	}
+
+	// запрос не привязан к ctx первого вызова: его отмена не должна оборвать ответ остальным ожидающим
+	ch := v.group.DoChan(
+		userID, func() (interface{}, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), v.timeout)
+			defer cancel()
+
+			_, err := v.client.GetUser(ctx, &pb.GetUserRequest{Id: userID})
+			switch status.Code(err) {
+			case codes.OK:
+				v.store(userID, true)
+				return true, nil
+			case codes.NotFound, codes.InvalidArgument:
+				v.store(userID, false)
+				return false, nil
+			}
+
+			return false, fmt.Errorf("users GetUser: %w", err)
+		},
+	)
+
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case result := <-ch:
+		if result.Err != nil {
+			return false, result.Err
+		}
+
+		return result.Val.(bool), nil
+	}
+}
+
+func (v *Validator) cached(userID string) (bool, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	e, ok := v.cache[userID]
+	if !ok || time.Now().After(e.expiresAt) {
+		return false, false
+	}
+
+	return e.exists, true
+}
+
+func (v *Validator) store(userID string, exists bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if len(v.cache) >= v.maxEntries {
+		now := time.Now()
+		for id, e := range v.cache {
+			if now.After(e.expiresAt) {
+				delete(v.cache, id)
+			}
+		}
+		if len(v.cache) >= v.maxEntries {
+			v.cache = make(map[string]entry)
+		}
+	}
+
+	v.cache[userID] = entry{exists: exists, expiresAt: time.Now().Add(v.ttl)}
+}
@@ -0,0 +1,120 @@
+package owners
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+type stubUsers struct {
+	calls   atomic.Int32
+	release chan struct{}
+	code    codes.Code
+}
+
+func (c *stubUsers) GetUser(_ context.Context, in *pb.GetUserRequest, _ ...grpc.CallOption) (*pb.User, error) {
+	c.calls.Add(1)
+	if c.release != nil {
+		<-c.release
+	}
+
+	switch {
+	case c.code != codes.OK:
+		return nil, status.Error(c.code, "users-srv")
+	case in.Id == "known":
+		return &pb.User{Id: in.Id}, nil
+	}
+
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func TestValidator_Exists(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	users := &stubUsers{}
+	v := New(users, Params{CacheTTL: time.Minute, MaxEntries: 10, Timeout: time.Second})
+
+	for i := 0; i < 3; i++ {
+		exists, err := v.Exists(ctx, "known")
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		exists, err = v.Exists(ctx, "unknown")
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+	require.Equal(t, int32(2), users.calls.Load())
+
+	// ошибка users-srv не кешируется и не выдается за отсутствие пользователя
+	users.code = codes.Unavailable
+	_, err := v.Exists(ctx, "other")
+	require.Error(t, err)
+	_, err = v.Exists(ctx, "other")
+	require.Error(t, err)
+	require.Equal(t, int32(4), users.calls.Load())
+
+	disabled := New(users, Params{Disabled: true})
+	exists, err := disabled.Exists(ctx, "unknown")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, int32(4), users.calls.Load())
+}
+
+func TestValidator_ExistsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	users := &stubUsers{release: make(chan struct{})}
+	v := New(users, Params{CacheTTL: time.Minute, MaxEntries: 10, Timeout: time.Second})
+
+	// require в чужой горутине не останавливает тест, поэтому результаты проверяются после Wait
+	type result struct {
+		exists bool
+		err    error
+	}
+	results := make([]result, 20)
+
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			exists, err := v.Exists(context.Background(), "known")
+			results[i] = result{exists: exists, err: err}
+		}(i)
+	}
+
+	require.Eventually(t, func() bool { return users.calls.Load() == 1 }, time.Second, time.Millisecond)
+	close(users.release)
+	wg.Wait()
+
+	for _, r := range results {
+		require.NoError(t, r.err)
+		require.True(t, r.exists)
+	}
+	require.Equal(t, int32(1), users.calls.Load())
+}
+
+func TestValidator_storeEvicts(t *testing.T) {
+	t.Parallel()
+
+	v := New(&stubUsers{}, Params{CacheTTL: time.Minute, MaxEntries: 2, Timeout: time.Second})
+
+	v.store("a", true)
+	v.store("b", true)
+	v.store("c", true)
+
+	require.Len(t, v.cache, 1)
+	_, ok := v.cached("c")
+	require.True(t, ok)
+}
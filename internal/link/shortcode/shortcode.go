@@ -0,0 +1,58 @@
+package shortcode
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	MinAliasLength = 3
+	MaxAliasLength = 32
+)
+
+var ErrInvalidAlias = errors.New("invalid alias")
+
+// Generator выдает случайные base62 коды фиксированной длины. Уникальность проверяет база, при коллизии нужно
+// сгенерировать код заново
+type Generator struct {
+	length int
+}
+
+func New(length int) *Generator {
+	return &Generator{length: length}
+}
+
+func (g *Generator) Generate() (string, error) {
+	max := big.NewInt(int64(len(alphabet)))
+	code := make([]byte, g.length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("rand Int: %w", err)
+		}
+		code[i] = alphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+// ValidateAlias проверяет пользовательский код: латиница, цифры, '-' и '_'
+func ValidateAlias(alias string) error {
+	if len(alias) < MinAliasLength || len(alias) > MaxAliasLength {
+		return fmt.Errorf("%w: length must be between %d and %d", ErrInvalidAlias, MinAliasLength, MaxAliasLength)
+	}
+
+	for _, r := range alias {
+		switch {
+		case r >= '0' && r <= '9', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '-', r == '_':
+		default:
+			return fmt.Errorf("%w: unexpected character %q", ErrInvalidAlias, r)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package shortcode
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_Generate(t *testing.T) {
+	t.Parallel()
+
+	g := New(7)
+	seen := make(map[string]struct{})
+	for i := 0; i < 1000; i++ {
+		code, err := g.Generate()
+		require.NoError(t, err)
+		require.Len(t, code, 7)
+		require.NoError(t, ValidateAlias(code))
+
+		seen[code] = struct{}{}
+	}
+
+	require.Greater(t, len(seen), 990)
+}
+
+func TestValidateAlias(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateAlias("my-blog_2024"))
+
+	for _, alias := range []string{"ab", strings.Repeat("a", MaxAliasLength+1), "with space", "кириллица", "a/b"} {
+		require.True(t, errors.Is(ValidateAlias(alias), ErrInvalidAlias), alias)
+	}
+}
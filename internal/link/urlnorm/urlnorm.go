@@ -0,0 +1,55 @@
+package urlnorm
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+var ErrInvalidURL = errors.New("invalid url")
+
+var defaultPorts = map[string]string{"http": "80", "https": "443"}
+
+// trackingParams параметры, которые добавляют рассылки и рекламные системы. На содержимое страницы они не влияют
+var trackingParams = map[string]struct{}{"fbclid": {}, "gclid": {}}
+
+// Normalize приводит url к канонической форме, по которой ищутся дубликаты: схема и хост в нижнем регистре, без
+// порта по умолчанию, фрагмента и трекинговых параметров, query отсортирован по ключам
+func Normalize(rawURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidURL, err)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	port, ok := defaultPorts[u.Scheme]
+	if !ok || u.Hostname() == "" {
+		return "", fmt.Errorf("%w: absolute http(s) url expected", ErrInvalidURL)
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if p := u.Port(); p != "" && p != port {
+		host = net.JoinHostPort(host, p)
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]" // ipv6 без порта
+	}
+	u.Host = host
+
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	u.Fragment, u.RawFragment = "", ""
+
+	query := u.Query()
+	for key := range query {
+		if _, ok := trackingParams[strings.ToLower(key)]; ok || strings.HasPrefix(strings.ToLower(key), "utm_") {
+			query.Del(key)
+		}
+	}
+	u.RawQuery = query.Encode() // Encode сортирует по ключам
+	u.ForceQuery = false
+
+	return u.String(), nil
+}
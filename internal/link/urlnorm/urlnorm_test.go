@@ -0,0 +1,34 @@
+package urlnorm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"https://ya.ru":                               "https://ya.ru/",
+		"HTTPS://Ya.RU:443/Path":                      "https://ya.ru/Path",
+		"http://ya.ru:80/?b=2&a=1#section":            "http://ya.ru/?a=1&b=2",
+		"http://ya.ru:8080/":                          "http://ya.ru:8080/",
+		"https://ya.ru/?utm_source=mail&UTM_Medium=x": "https://ya.ru/",
+		"https://ya.ru/p?fbclid=1&q=go&gclid=2":       "https://ya.ru/p?q=go",
+		"https://ya.ru./?":                            "https://ya.ru/",
+		"https://[::1]:443/":                          "https://[::1]/",
+		"https://[::1]:8443/":                         "https://[::1]:8443/",
+	}
+	for raw, want := range cases {
+		got, err := Normalize(raw)
+		require.NoError(t, err, raw)
+		require.Equal(t, want, got, raw)
+	}
+
+	for _, raw := range []string{"", "ya.ru", "/relative", "ftp://ya.ru", "https://", "http://[::1"} {
+		_, err := Normalize(raw)
+		require.True(t, errors.Is(err, ErrInvalidURL), raw)
+	}
+}
@@ -0,0 +1,125 @@
+package visits
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+type visitsRepository interface {
+	InsertVisits(ctx context.Context, visits []database.Visit) error
+}
+
+// Visit переход в том виде, в котором его видит api-gw. Recorder хранит вместо ClientIP его хеш
+type Visit struct {
+	LinkID    primitive.ObjectID
+	VisitedAt time.Time
+	Referrer  string
+	UserAgent string
+	ClientIP  string
+}
+
+type Params struct {
+	Salt          []byte // ключ HMAC для хеша ip, без него хеш легко перебрать по всему пространству адресов
+	BufferSize    int
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+// Recorder копит переходы в очереди и пишет их в базу пачками, чтобы запись статистики не замедляла редирект
+type Recorder struct {
+	repo          visitsRepository
+	salt          []byte
+	batchSize     int
+	flushInterval time.Duration
+	queue         chan database.Visit
+}
+
+func New(repo visitsRepository, params Params) *Recorder {
+	return &Recorder{
+		repo:          repo,
+		salt:          params.Salt,
+		batchSize:     params.BatchSize,
+		flushInterval: params.FlushInterval,
+		queue:         make(chan database.Visit, params.BufferSize),
+	}
+}
+
+// Record ставит переход в очередь без ожидания. Если очередь переполнена, переход теряется и возвращается false:
+// статистика не должна тормозить редирект
+func (r *Recorder) Record(v Visit) bool {
+	select {
+	case r.queue <- database.Visit{
+		LinkID:    v.LinkID,
+		VisitedAt: v.VisitedAt,
+		Referrer:  v.Referrer,
+		UserAgent: v.UserAgent,
+		IPHash:    r.hashIP(v.ClientIP),
+	}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run пишет пачку, когда она набрана или прошел flushInterval. После отмены ctx дописывает очередь и выходит
+func (r *Recorder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]database.Visit, 0, r.batchSize)
+	for {
+		select {
+		case v := <-r.queue:
+			if batch = append(batch, v); len(batch) >= r.batchSize {
+				batch = r.flush(batch)
+			}
+		case <-ticker.C:
+			batch = r.flush(batch)
+		case <-ctx.Done():
+			r.drain(batch)
+			return
+		}
+	}
+}
+
+func (r *Recorder) drain(batch []database.Visit) {
+	for {
+		select {
+		case v := <-r.queue:
+			if batch = append(batch, v); len(batch) >= r.batchSize {
+				batch = r.flush(batch)
+			}
+		default:
+			r.flush(batch)
+			return
+		}
+	}
+}
+
+// flush не зависит от ctx из Run, чтобы отмена не оборвала запись уже набранной пачки. Таймаут задает репозиторий
+func (r *Recorder) flush(batch []database.Visit) []database.Visit {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	if err := r.repo.InsertVisits(context.Background(), batch); err != nil {
+		slog.Error("visits InsertVisits", slog.Int("count", len(batch)), slog.Any("err", err))
+	}
+
+	return batch[:0]
+}
+
+func (r *Recorder) hashIP(ip string) string {
+	mac := hmac.New(sha256.New, r.salt)
+	mac.Write([]byte(ip))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
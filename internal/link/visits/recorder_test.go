@@ -0,0 +1,90 @@
+package visits
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+)
+
+type stubRepository struct {
+	mu      sync.Mutex
+	batches [][]database.Visit
+}
+
+func (r *stubRepository) InsertVisits(_ context.Context, visits []database.Visit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.batches = append(r.batches, append([]database.Visit(nil), visits...))
+
+	return nil
+}
+
+func (r *stubRepository) sizes() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sizes := make([]int, 0, len(r.batches))
+	for _, b := range r.batches {
+		sizes = append(sizes, len(b))
+	}
+
+	return sizes
+}
+
+func TestRecorder_Run(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepository{}
+	recorder := New(repo, Params{Salt: []byte("salt"), BufferSize: 10, BatchSize: 2, FlushInterval: time.Hour})
+
+	linkID := primitive.NewObjectID()
+	for _, ip := range []string{"10.0.0.1", "10.0.0.1", "10.0.0.2"} {
+		require.True(t, recorder.Record(Visit{LinkID: linkID, VisitedAt: time.Now(), ClientIP: ip}))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		recorder.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool { return len(repo.sizes()) == 1 }, time.Second, 10*time.Millisecond)
+
+	// неполная пачка дописывается при остановке
+	cancel()
+	<-done
+	require.Equal(t, []int{2, 1}, repo.sizes())
+
+	first, third := repo.batches[0][0], repo.batches[1][0]
+	require.Equal(t, linkID, first.LinkID)
+	require.Equal(t, first.IPHash, repo.batches[0][1].IPHash)
+	require.NotEqual(t, first.IPHash, third.IPHash)
+	require.NotContains(t, first.IPHash, "10.0.0.1")
+}
+
+func TestRecorder_RecordFullQueue(t *testing.T) {
+	t.Parallel()
+
+	recorder := New(&stubRepository{}, Params{BufferSize: 1, BatchSize: 1, FlushInterval: time.Hour})
+
+	require.True(t, recorder.Record(Visit{ClientIP: "10.0.0.1"}))
+	require.False(t, recorder.Record(Visit{ClientIP: "10.0.0.2"}))
+}
+
+func TestRecorder_HashIPSalt(t *testing.T) {
+	t.Parallel()
+
+	a := New(&stubRepository{}, Params{Salt: []byte("a")})
+	b := New(&stubRepository{}, Params{Salt: []byte("b")})
+
+	require.Equal(t, a.hashIP("10.0.0.1"), a.hashIP("10.0.0.1"))
+	require.NotEqual(t, a.hashIP("10.0.0.1"), b.hashIP("10.0.0.1"))
+}
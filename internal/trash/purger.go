@@ -0,0 +1,65 @@
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type purgeRepository interface {
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
+
+type Params struct {
+	Interval  time.Duration
+	Retention time.Duration // сколько удаленные записи лежат в корзине и могут быть восстановлены
+}
+
+// Purger окончательно удаляет записи, пролежавшие в корзине дольше Retention. Общий для ссылок и пользователей:
+// каждый сервис запускает его со своим репозиторием
+type Purger struct {
+	name      string
+	repo      purgeRepository
+	interval  time.Duration
+	retention time.Duration
+}
+
+// New name попадает в логи и отличает корзины разных сервисов
+func New(name string, repo purgeRepository, params Params) *Purger {
+	return &Purger{
+		name:      name,
+		repo:      repo,
+		interval:  params.Interval,
+		retention: params.Retention,
+	}
+}
+
+// Run чистит корзину сразу и затем каждые interval до отмены ctx
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purge(ctx context.Context) {
+	purged, err := p.repo.PurgeDeleted(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("trash PurgeDeleted", slog.String("trash", p.name), slog.Any("err", err))
+		}
+		return
+	}
+
+	if purged > 0 {
+		slog.Info("trash purged", slog.String("trash", p.name), slog.Int64("count", purged))
+	}
+}
@@ -0,0 +1,55 @@
+package trash
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	mu    sync.Mutex
+	calls []time.Time
+}
+
+func (r *stubRepository) PurgeDeleted(_ context.Context, deletedBefore time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, deletedBefore)
+
+	return 1, nil
+}
+
+func (r *stubRepository) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.calls)
+}
+
+func TestPurger_Run(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepository{}
+	p := New("links", repo, Params{Interval: 10 * time.Millisecond, Retention: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool { return repo.count() >= 3 }, time.Second, 5*time.Millisecond)
+	cancel()
+	<-done
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	// граница сдвинута на срок хранения назад от момента очистки
+	require.WithinDuration(t, time.Now().Add(-time.Hour), repo.calls[0], time.Second)
+}
@@ -0,0 +1,218 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+type outboxRepository interface {
+	ClaimOutbox(ctx context.Context, limit int, leaseUntil time.Time) ([]database.OutboxEvent, error)
+	CompleteOutbox(ctx context.Context, id int64) error
+	RetryOutbox(ctx context.Context, id int64, nextAttempt time.Time, lastErr string) error
+	DeadLetterOutbox(ctx context.Context, id int64, lastErr string) error
+	FindByID(ctx context.Context, userID uuid.UUID) (database.User, error)
+}
+
+type linksClient interface {
+	DeleteLinksByUserID(
+		ctx context.Context,
+		in *pb.DeleteLinksByUserIDRequest,
+		opts ...grpc.CallOption,
+	) (*pb.DeleteLinksByUserIDResponse, error)
+	RestoreLinksByUserID(
+		ctx context.Context,
+		in *pb.RestoreLinksByUserIDRequest,
+		opts ...grpc.CallOption,
+	) (*pb.RestoreLinksByUserIDResponse, error)
+}
+
+type Params struct {
+	Interval   time.Duration // как часто проверять outbox, он же первая задержка перед повтором
+	BatchSize  int
+	Lease      time.Duration // на сколько событие скрывается от других реплик, должно быть больше Timeout
+	Timeout    time.Duration // на доставку одного события
+	MaxBackoff time.Duration
+	// MaxAttempts после стольких неудачных попыток событие больше не доставляется и остается в outbox с dead_at.
+	// 0 без ограничения
+	MaxAttempts int
+}
+
+// Relay доставляет события из outbox в links-srv. Доставка не реже одного раза: обработчики событий должны быть
+// идемпотентными
+type Relay struct {
+	repo        outboxRepository
+	links       linksClient
+	interval    time.Duration
+	batchSize   int
+	lease       time.Duration
+	timeout     time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
+}
+
+func New(repo outboxRepository, links linksClient, params Params) *Relay {
+	return &Relay{
+		repo:        repo,
+		links:       links,
+		interval:    params.Interval,
+		batchSize:   params.BatchSize,
+		lease:       params.Lease,
+		timeout:     params.Timeout,
+		maxBackoff:  params.MaxBackoff,
+		maxAttempts: params.MaxAttempts,
+	}
+}
+
+// Run разбирает outbox каждые interval до отмены ctx. Полная пачка разбирается следующей сразу, без ожидания
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if r.relay(ctx) == r.batchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay доставляет одну пачку и возвращает ее размер
+func (r *Relay) relay(ctx context.Context) int {
+	events, err := r.repo.ClaimOutbox(ctx, r.batchSize, time.Now().Add(r.lease))
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("outbox ClaimOutbox", slog.Any("err", err))
+		}
+		return 0
+	}
+
+	for _, e := range events {
+		if err := r.deliver(ctx, e); err != nil {
+			if r.maxAttempts > 0 && e.Attempts >= r.maxAttempts {
+				slog.Error(
+					"outbox event dead-lettered",
+					slog.Int64("id", e.ID), slog.String("topic", e.Topic), slog.Int("attempts", e.Attempts),
+					slog.Any("err", err),
+				)
+				if err := r.repo.DeadLetterOutbox(ctx, e.ID, err.Error()); err != nil {
+					slog.Error("outbox DeadLetterOutbox", slog.Int64("id", e.ID), slog.Any("err", err))
+				}
+				continue
+			}
+
+			next := time.Now().Add(r.backoff(e.Attempts))
+			slog.Warn(
+				"outbox event not delivered",
+				slog.Int64("id", e.ID), slog.String("topic", e.Topic), slog.Int("attempts", e.Attempts),
+				slog.Time("next_attempt", next), slog.Any("err", err),
+			)
+			if err := r.repo.RetryOutbox(ctx, e.ID, next, err.Error()); err != nil {
+				// событие вернется в очередь, когда истечет lease
+				slog.Error("outbox RetryOutbox", slog.Int64("id", e.ID), slog.Any("err", err))
+			}
+			continue
+		}
+
+		if err := r.repo.CompleteOutbox(ctx, e.ID); err != nil {
+			// событие доставится повторно, это допустимо
+			slog.Error("outbox CompleteOutbox", slog.Int64("id", e.ID), slog.Any("err", err))
+		}
+	}
+
+	return len(events)
+}
+
+func (r *Relay) deliver(ctx context.Context, e database.OutboxEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	switch e.Topic {
+	case database.TopicUserDeleted:
+		var event database.UserDeletedEvent
+		if err := json.Unmarshal(e.Payload, &event); err != nil {
+			return fmt.Errorf("json Unmarshal: %w", err)
+		}
+
+		// пока событие ждало повтора, пользователя могли восстановить
+		if active, err := r.userActive(ctx, event.UserID); err != nil || active {
+			return err
+		}
+
+		resp, err := r.links.DeleteLinksByUserID(ctx, &pb.DeleteLinksByUserIDRequest{UserId: event.UserID})
+		if err != nil {
+			return fmt.Errorf("links DeleteLinksByUserID: %w", err)
+		}
+
+		slog.Info("user links moved to trash", slog.String("user_id", event.UserID), slog.Int64("count", resp.Deleted))
+
+		return nil
+	case database.TopicUserRestored:
+		var event database.UserRestoredEvent
+		if err := json.Unmarshal(e.Payload, &event); err != nil {
+			return fmt.Errorf("json Unmarshal: %w", err)
+		}
+
+		// пока событие ждало повтора, пользователя могли снова удалить
+		if active, err := r.userActive(ctx, event.UserID); err != nil || !active {
+			return err
+		}
+
+		resp, err := r.links.RestoreLinksByUserID(ctx, &pb.RestoreLinksByUserIDRequest{UserId: event.UserID})
+		if err != nil {
+			return fmt.Errorf("links RestoreLinksByUserID: %w", err)
+		}
+
+		slog.Info(
+			"user links restored from trash", slog.String("user_id", event.UserID), slog.Int64("count", resp.Restored),
+		)
+
+		return nil
+	}
+
+	// событие могла записать более новая версия сервиса, его доставит она. Если такой версии нет, событие исчерпает
+	// попытки и останется в outbox с dead_at
+	return fmt.Errorf("unknown topic %q", e.Topic)
+}
+
+// userActive проверяет, что пользователь существует и не лежит в корзине
+func (r *Relay) userActive(ctx context.Context, userID string) (bool, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return false, fmt.Errorf("uuid Parse: %w", err)
+	}
+
+	_, err = r.repo.FindByID(ctx, id)
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("users FindByID: %w", err)
+	}
+
+	return true, nil
+}
+
+// backoff удваивает задержку с каждой неудачной попыткой, но не дольше maxBackoff
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.interval
+	for i := 1; i < attempts && delay < r.maxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, r.maxBackoff)
+}
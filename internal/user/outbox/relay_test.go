@@ -0,0 +1,229 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+type stubRepository struct {
+	mu        sync.Mutex
+	events    []database.OutboxEvent
+	completed []int64
+	retried   map[int64]string
+	dead      map[int64]string
+	active    map[string]bool // пользователи не в корзине
+}
+
+func (r *stubRepository) ClaimOutbox(_ context.Context, limit int, _ time.Time) ([]database.OutboxEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := min(limit, len(r.events))
+	claimed := r.events[:n]
+	r.events = r.events[n:]
+	for i := range claimed {
+		claimed[i].Attempts++
+	}
+
+	return claimed, nil
+}
+
+func (r *stubRepository) CompleteOutbox(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.completed = append(r.completed, id)
+
+	return nil
+}
+
+func (r *stubRepository) RetryOutbox(_ context.Context, id int64, _ time.Time, lastErr string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.retried[id] = lastErr
+
+	return nil
+}
+
+func (r *stubRepository) DeadLetterOutbox(_ context.Context, id int64, lastErr string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dead[id] = lastErr
+
+	return nil
+}
+
+func (r *stubRepository) FindByID(_ context.Context, userID uuid.UUID) (database.User, error) {
+	if !r.active[userID.String()] {
+		return database.User{}, database.ErrNotFound
+	}
+
+	return database.User{ID: userID}, nil
+}
+
+func (r *stubRepository) done() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.completed) + len(r.retried) + len(r.dead)
+}
+
+type stubLinks struct {
+	mu       sync.Mutex
+	users    []string
+	restored []string
+}
+
+func (c *stubLinks) DeleteLinksByUserID(
+	_ context.Context,
+	in *pb.DeleteLinksByUserIDRequest,
+	_ ...grpc.CallOption,
+) (*pb.DeleteLinksByUserIDResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if in.UserId == unavailable {
+		return nil, errors.New("links-srv is unavailable")
+	}
+	c.users = append(c.users, in.UserId)
+
+	return &pb.DeleteLinksByUserIDResponse{Deleted: 1}, nil
+}
+
+func (c *stubLinks) RestoreLinksByUserID(
+	_ context.Context,
+	in *pb.RestoreLinksByUserIDRequest,
+	_ ...grpc.CallOption,
+) (*pb.RestoreLinksByUserIDResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.restored = append(c.restored, in.UserId)
+
+	return &pb.RestoreLinksByUserIDResponse{Restored: 1}, nil
+}
+
+// unavailable пользователь, на ссылках которого links-srv отвечает ошибкой
+const unavailable = "7c0c5a4e-2b1e-4a8f-9d0e-5f3b6c2a1d90"
+
+func userDeleted(t *testing.T, id int64, userID string) database.OutboxEvent {
+	t.Helper()
+
+	payload, err := json.Marshal(database.UserDeletedEvent{UserID: userID})
+	require.NoError(t, err)
+
+	return database.OutboxEvent{ID: id, Topic: database.TopicUserDeleted, Payload: payload}
+}
+
+func userRestored(t *testing.T, id int64, userID string) database.OutboxEvent {
+	t.Helper()
+
+	payload, err := json.Marshal(database.UserRestoredEvent{UserID: userID})
+	require.NoError(t, err)
+
+	return database.OutboxEvent{ID: id, Topic: database.TopicUserRestored, Payload: payload}
+}
+
+func TestRelay_Run(t *testing.T) {
+	t.Parallel()
+
+	u1, u4, restored := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	repo := &stubRepository{
+		events: []database.OutboxEvent{
+			userDeleted(t, 1, u1),
+			userDeleted(t, 2, unavailable),
+			{ID: 3, Topic: "user.renamed", Payload: []byte(`{}`)},
+			userDeleted(t, 4, u4),
+			// пользователя восстановили раньше, чем доставилось удаление: ссылки не трогаются
+			userDeleted(t, 5, restored),
+			userRestored(t, 6, restored),
+			// а этого удалили снова
+			userRestored(t, 7, u1),
+		},
+		retried: make(map[int64]string),
+		dead:    make(map[int64]string),
+		active:  map[string]bool{restored: true},
+	}
+	links := &stubLinks{}
+	r := New(
+		repo, links, Params{
+			Interval:   time.Hour, // все события должны разойтись без ожидания тикера
+			BatchSize:  2,
+			Lease:      time.Minute,
+			Timeout:    time.Second,
+			MaxBackoff: time.Hour,
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool { return repo.done() == 7 }, time.Second, 5*time.Millisecond)
+	cancel()
+	<-done
+
+	require.Equal(t, []int64{1, 4, 5, 6, 7}, repo.completed)
+	require.Equal(t, []string{u1, u4}, links.users)
+	require.Equal(t, []string{restored}, links.restored)
+	require.Contains(t, repo.retried[2], "unavailable")
+	require.Contains(t, repo.retried[3], "unknown topic")
+}
+
+func TestRelay_DeadLetter(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepository{
+		events: []database.OutboxEvent{
+			{ID: 1, Topic: "user.renamed", Payload: []byte(`{}`), Attempts: 2},
+			{ID: 2, Topic: "user.renamed", Payload: []byte(`{}`), Attempts: 1},
+		},
+		retried: make(map[int64]string),
+		dead:    make(map[int64]string),
+	}
+	r := New(
+		repo, &stubLinks{}, Params{
+			Interval:    time.Hour,
+			BatchSize:   10,
+			Lease:       time.Minute,
+			Timeout:     time.Second,
+			MaxBackoff:  time.Hour,
+			MaxAttempts: 3,
+		},
+	)
+
+	require.Equal(t, 2, r.relay(context.Background()))
+
+	// третья попытка последняя, вторая еще повторяется
+	require.Contains(t, repo.dead[1], "unknown topic")
+	require.Contains(t, repo.retried, int64(2))
+	require.NotContains(t, repo.dead, int64(2))
+}
+
+func TestRelay_backoff(t *testing.T) {
+	t.Parallel()
+
+	r := New(nil, nil, Params{Interval: time.Second, MaxBackoff: 10 * time.Second})
+
+	require.Equal(t, time.Second, r.backoff(1))
+	require.Equal(t, 2*time.Second, r.backoff(2))
+	require.Equal(t, 8*time.Second, r.backoff(4))
+	require.Equal(t, 10*time.Second, r.backoff(5))
+	require.Equal(t, 10*time.Second, r.backoff(100))
+}
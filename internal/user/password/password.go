@@ -0,0 +1,136 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Хеш хранится в формате PHC:
+//
+//	$argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+//
+// Алгоритм, его версия и параметры сохраняются вместе с хешем, поэтому параметры можно усилить в конфиге, а
+// старые хеши будут пересчитаны при следующем входе пользователя (см. NeedsRehash).
+const scheme = "argon2id"
+
+var (
+	ErrMismatch      = errors.New("password mismatch")
+	ErrInvalidFormat = errors.New("invalid password hash format")
+)
+
+type Params struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+func New(params Params) *Hasher {
+	return &Hasher{params: params}
+}
+
+type Hasher struct {
+	params Params
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("rand Read: %w", err)
+	}
+
+	return encode(h.params, salt, derive(h.params, password, salt)), nil
+}
+
+// Verify проверяет пароль. Хеши без префикса считаются паролями, сохраненными до появления хеширования, они
+// сравниваются как есть и всегда требуют пересчета.
+func (h *Hasher) Verify(password, encoded string) error {
+	if !strings.HasPrefix(encoded, "$") {
+		if subtle.ConstantTimeCompare([]byte(password), []byte(encoded)) != 1 {
+			return ErrMismatch
+		}
+		return nil
+	}
+
+	params, salt, key, err := decode(encoded)
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare(key, derive(params, password, salt)) != 1 {
+		return ErrMismatch
+	}
+
+	return nil
+}
+
+// NeedsRehash сообщает, что хеш создан другим алгоритмом, версией или параметрами, отличными от текущих
+func (h *Hasher) NeedsRehash(encoded string) bool {
+	params, salt, key, err := decode(encoded)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		uint32(len(salt)) != h.params.SaltLength ||
+		uint32(len(key)) != h.params.KeyLength
+}
+
+func derive(params Params, password string, salt []byte) []byte {
+	return argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+}
+
+func encode(params Params, salt, key []byte) string {
+	return fmt.Sprintf(
+		"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		scheme, argon2.Version, params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func decode(encoded string) (Params, []byte, []byte, error) {
+	var params Params
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != scheme {
+		return params, nil, nil, ErrInvalidFormat
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, ErrInvalidFormat
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("%w: unsupported argon2 version %d", ErrInvalidFormat, version)
+	}
+
+	if _, err := fmt.Sscanf(
+		parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism,
+	); err != nil {
+		return params, nil, nil, ErrInvalidFormat
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrInvalidFormat
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, ErrInvalidFormat
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
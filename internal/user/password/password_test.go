@@ -0,0 +1,69 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testParams = Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHasher_HashVerify(t *testing.T) {
+	t.Parallel()
+
+	h := New(testParams)
+
+	encoded, err := h.Hash("secret")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	require.NoError(t, h.Verify("secret", encoded))
+	require.True(t, errors.Is(h.Verify("wrong", encoded), ErrMismatch))
+	require.False(t, h.NeedsRehash(encoded))
+
+	other, err := h.Hash("secret")
+	require.NoError(t, err)
+	require.NotEqual(t, encoded, other)
+}
+
+func TestHasher_NeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	old := New(testParams)
+	encoded, err := old.Hash("secret")
+	require.NoError(t, err)
+
+	stronger := testParams
+	stronger.Iterations = 2
+	h := New(stronger)
+
+	require.NoError(t, h.Verify("secret", encoded))
+	require.True(t, h.NeedsRehash(encoded))
+}
+
+func TestHasher_Legacy(t *testing.T) {
+	t.Parallel()
+
+	h := New(testParams)
+
+	require.NoError(t, h.Verify("password", "password"))
+	require.True(t, errors.Is(h.Verify("other", "password"), ErrMismatch))
+	require.True(t, h.NeedsRehash("password"))
+}
+
+func TestHasher_InvalidFormat(t *testing.T) {
+	t.Parallel()
+
+	h := New(testParams)
+
+	for _, encoded := range []string{
+		"$bcrypt$v=19$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5",
+	} {
+		require.True(t, errors.Is(h.Verify("secret", encoded), ErrInvalidFormat), encoded)
+	}
+}
@@ -0,0 +1,114 @@
+package usergrpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/user/password"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+const invalidCredentials = "invalid username or password"
+
+func (h Handler) Login(ctx context.Context, in *pb.LoginRequest) (*pb.TokenResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	user, err := h.usersRepository.FindByUsername(ctx, in.Username)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			_ = h.passwordHasher.Verify(in.Password, h.dummyHash)
+			return nil, status.Error(codes.Unauthenticated, invalidCredentials)
+		}
+
+		return nil, statusFromError(err)
+	}
+
+	if err := h.passwordHasher.Verify(in.Password, user.Password); err != nil {
+		if errors.Is(err, password.ErrMismatch) {
+			return nil, status.Error(codes.Unauthenticated, invalidCredentials)
+		}
+
+		return nil, domain.Internal(err)
+	}
+
+	// параметры хеширования поменялись или пароль сохранен до появления хеширования
+	if h.passwordHasher.NeedsRehash(user.Password) {
+		h.rehashPassword(ctx, user, in.Password)
+	}
+
+	return h.issueTokens(user)
+}
+
+func (h Handler) RefreshToken(ctx context.Context, in *pb.RefreshTokenRequest) (*pb.TokenResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	claims, err := h.tokenManager.Parse(in.RefreshToken, auth.RefreshToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	userID, err := uuid.Parse(claims.UserID())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// пользователь мог быть удален или сменить роль после выдачи токена
+	user, err := h.usersRepository.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "user no longer exists")
+		}
+
+		return nil, statusFromError(err)
+	}
+
+	return h.issueTokens(user)
+}
+
+func (h Handler) issueTokens(user database.User) (*pb.TokenResponse, error) {
+	tokens, err := h.tokenManager.Issue(user.ID.String(), auth.Role(user.Role))
+	if err != nil {
+		return nil, domain.Internal(err)
+	}
+
+	return &pb.TokenResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(tokens.ExpiresIn.Seconds()),
+	}, nil
+}
+
+func (h Handler) rehashPassword(ctx context.Context, user database.User, plain string) {
+	hash, err := h.passwordHasher.Hash(plain)
+	if err != nil {
+		slog.Error("password rehash", slog.Any("err", err))
+		return
+	}
+
+	if _, err := h.usersRepository.Update(
+		ctx, database.CreateUserReq{ID: user.ID, Password: hash, Fields: []string{"password"}},
+	); err != nil {
+		slog.Error("password rehash", slog.String("user_id", user.ID.String()), slog.Any("err", err))
+	}
+}
+
+// newDummyHash хеш случайного пароля с текущими параметрами: его проверка занимает столько же, сколько проверка
+// настоящего пароля. Результат проверки не используется
+func newDummyHash(hasher passwordHasher) string {
+	hash, err := hasher.Hash(uuid.NewString())
+	if err != nil {
+		slog.Error("dummy password hash", slog.Any("err", err))
+	}
+
+	return hash
+}
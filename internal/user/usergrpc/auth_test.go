@@ -0,0 +1,66 @@
+package usergrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/user/password"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
+)
+
+type stubRepository struct {
+	usersRepository
+	users map[string]database.User
+}
+
+func (r stubRepository) FindByUsername(_ context.Context, username string) (database.User, error) {
+	u, ok := r.users[username]
+	if !ok {
+		return database.User{}, database.ErrNotFound
+	}
+
+	return u, nil
+}
+
+// countingHasher считает проверки паролей
+type countingHasher struct {
+	*password.Hasher
+	verified []string
+}
+
+func (h *countingHasher) Verify(plain, encoded string) error {
+	h.verified = append(h.verified, encoded)
+	return h.Hasher.Verify(plain, encoded)
+}
+
+func TestHandler_LoginUnknownUserVerifiesDummyHash(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	hasher := &countingHasher{
+		Hasher: password.New(password.Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}),
+	}
+	hash, err := hasher.Hash("password")
+	require.NoError(t, err)
+
+	repo := stubRepository{users: map[string]database.User{"known": {ID: uuid.New(), Password: hash}}}
+	h := New(repo, hasher, auth.New([]byte("secret"), "umanager", time.Minute, time.Hour), time.Second)
+	require.NotEmpty(t, h.dummyHash)
+
+	_, err = h.Login(ctx, &pb.LoginRequest{Username: "known", Password: "wrong"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = h.Login(ctx, &pb.LoginRequest{Username: "unknown", Password: "wrong"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// неизвестный пользователь получает тот же ответ и ту же работу argon2id
+	require.Equal(t, []string{hash, h.dummyHash}, hasher.verified)
+	require.Equal(t, invalidCredentials, status.Convert(err).Message())
+}
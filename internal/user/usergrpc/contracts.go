@@ -5,12 +5,28 @@ import (
 
 	"github.com/google/uuid"
 
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
 )
 
 type usersRepository interface {
 	Create(ctx context.Context, req database.CreateUserReq) (database.User, error)
+	Update(ctx context.Context, req database.CreateUserReq) (database.User, error)
 	FindByID(ctx context.Context, userID uuid.UUID) (database.User, error)
 	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
-	FindAll(ctx context.Context) ([]database.User, error)
+	RestoreByUserID(ctx context.Context, userID uuid.UUID) (database.User, error)
+	FindAll(ctx context.Context, page database.PageReq) ([]database.User, string, error)
+	FindDeleted(ctx context.Context, page database.PageReq) ([]database.User, string, error)
+	FindByUsername(ctx context.Context, username string) (database.User, error)
+}
+
+type passwordHasher interface {
+	Hash(password string) (string, error)
+	Verify(password, encoded string) error
+	NeedsRehash(encoded string) bool
+}
+
+type tokenManager interface {
+	Issue(userID string, role auth.Role) (auth.Tokens, error)
+	Parse(token string, kind auth.TokenKind) (auth.Claims, error)
 }
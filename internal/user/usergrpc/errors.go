@@ -0,0 +1,21 @@
+package usergrpc
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/protoadapt"
+
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/grpcerr"
+)
+
+// domain попадает в ErrorInfo всех статусов users-srv
+const domain grpcerr.Domain = "users.umanager"
+
+// statusFromError статус users-srv по ошибке репозитория
+func statusFromError(err error, details ...protoadapt.MessageV1) error {
+	return domain.FromError(err, "user", details...)
+}
+
+// userResource ResourceInfo пользователя, к которому относится ошибка
+func userResource(id uuid.UUID) protoadapt.MessageV1 {
+	return grpcerr.Resource("user", id.String())
+}
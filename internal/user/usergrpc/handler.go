@@ -2,55 +2,76 @@ package usergrpc
 
 import (
 	"context"
-	"errors"
 	"github.com/google/uuid"
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/auth"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/database"
 	"time"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
+	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/internal/fieldmask"
 	"gitlab.com/robotomize/gb-golang/homework/03-02-umanager/pkg/pb"
 )
 
 var _ pb.UserServiceServer = (*Handler)(nil)
 
-func New(usersRepository usersRepository, timeout time.Duration) *Handler {
-	return &Handler{usersRepository: usersRepository, timeout: timeout}
+func New(
+	usersRepository usersRepository,
+	passwordHasher passwordHasher,
+	tokenManager tokenManager,
+	timeout time.Duration,
+) *Handler {
+	return &Handler{
+		usersRepository: usersRepository,
+		passwordHasher:  passwordHasher,
+		tokenManager:    tokenManager,
+		timeout:         timeout,
+		dummyHash:       newDummyHash(passwordHasher),
+	}
 }
 
 type Handler struct {
 	pb.UnimplementedUserServiceServer
 	usersRepository usersRepository
+	passwordHasher  passwordHasher
+	tokenManager    tokenManager
 	timeout         time.Duration
+	// dummyHash проверяется при входе несуществующего пользователя, чтобы время ответа не выдавало, есть ли он
+	dummyHash string
 }
 
-func (h Handler) CreateUser(ctx context.Context, in *pb.CreateUserRequest) (*pb.Empty, error) {
+func (h Handler) CreateUser(ctx context.Context, in *pb.CreateUserRequest) (*pb.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
 	// implemented
-	parsedUUID, err := uuid.Parse(in.Id)
+	parsedUUID, err := newUserID(in.Id)
+	if err != nil {
+		return nil, domain.InvalidArgument("id", err.Error())
+	}
+
+	if in.Role != "" {
+		if _, err := auth.ParseRole(in.Role); err != nil {
+			return nil, domain.InvalidArgument("role", err.Error())
+		}
+	}
+
+	passwordHash, err := h.hashPassword(in.Password)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
-	_, err = h.usersRepository.Create(
+	user, err := h.usersRepository.Create(
 		ctx, database.CreateUserReq{
 			ID:       parsedUUID,
 			Username: in.Username,
-			Password: in.Password,
+			Password: passwordHash,
+			Role:     in.Role,
 		},
 	)
 	if err != nil {
-		if errors.Is(err, errors.New("conflict")) {
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		}
-
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err, userResource(parsedUUID))
 	}
 
-	return &pb.Empty{}, nil
+	return userToPB(user), nil
 }
 
 func (h Handler) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.User, error) {
@@ -60,49 +81,57 @@ func (h Handler) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.User,
 	// implemented
 	parsedUUID, err := uuid.Parse(in.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, domain.InvalidArgument("id", err.Error())
 	}
 
 	user, err := h.usersRepository.FindByID(ctx, parsedUUID)
 	if err != nil {
-		if errors.Is(err, errors.New("not found")) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err, userResource(parsedUUID))
 	}
 
-	return &pb.User{
-		Id:        user.ID.String(),
-		Username:  user.Username,
-		Password:  user.Password,
-		CreatedAt: user.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
-	}, nil
+	return userToPB(user), nil
 }
 
-func (h Handler) UpdateUser(ctx context.Context, in *pb.UpdateUserRequest) (*pb.Empty, error) {
+func (h Handler) UpdateUser(ctx context.Context, in *pb.UpdateUserRequest) (*pb.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
 	// implemented
 	parsedUUID, err := uuid.Parse(in.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, domain.InvalidArgument("id", err.Error())
 	}
 
-	_, err = h.usersRepository.Create(
+	if in.Role != "" {
+		if _, err := auth.ParseRole(in.Role); err != nil {
+			return nil, domain.InvalidArgument("role", err.Error())
+		}
+	}
+
+	// пароль вне маски не меняется, и пустым он быть может
+	var passwordHash string
+	if fieldmask.Contains(in.UpdateMask, "password") {
+		if passwordHash, err = h.hashPassword(in.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	// без маски меняются все поля, но только у существующего пользователя: PUT не создает пользователя
+	user, err := h.usersRepository.Update(
 		ctx, database.CreateUserReq{
-			ID:       parsedUUID,
-			Username: in.Username,
-			Password: in.Password,
+			ID:              parsedUUID,
+			Username:        in.Username,
+			Password:        passwordHash,
+			Role:            in.Role,
+			ExpectedVersion: in.ExpectedVersion,
+			Fields:          in.UpdateMask.GetPaths(),
 		},
 	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err, userResource(parsedUUID))
 	}
 
-	return &pb.Empty{}, nil
+	return userToPB(user), nil
 }
 
 func (h Handler) DeleteUser(
@@ -115,11 +144,11 @@ func (h Handler) DeleteUser(
 	// implemented
 	parsedUUID, err := uuid.Parse(in.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, domain.InvalidArgument("id", err.Error())
 	}
 
 	if err := h.usersRepository.DeleteByUserID(ctx, parsedUUID); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err, userResource(parsedUUID))
 	}
 
 	return &pb.Empty{}, nil
@@ -127,30 +156,94 @@ func (h Handler) DeleteUser(
 
 func (h Handler) ListUsers(
 	ctx context.Context,
-	in *pb.Empty,
+	in *pb.ListUsersRequest,
 ) (*pb.ListUsersResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
 	// implemented
-	list, err := h.usersRepository.FindAll(ctx)
+	list, next, err := h.usersRepository.FindAll(ctx, database.PageReq{Size: int(in.PageSize), Cursor: in.PageToken})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusFromError(err)
 	}
 
-	response := make([]*pb.User, 0, len(list))
+	return &pb.ListUsersResponse{Users: usersToPB(list), NextPageToken: next}, nil
+}
+
+func (h Handler) RestoreUser(ctx context.Context, in *pb.RestoreUserRequest) (*pb.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	parsedUUID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, domain.InvalidArgument("id", err.Error())
+	}
+
+	user, err := h.usersRepository.RestoreByUserID(ctx, parsedUUID)
+	if err != nil {
+		return nil, statusFromError(err, userResource(parsedUUID))
+	}
+
+	return userToPB(user), nil
+}
+
+func (h Handler) ListTrash(ctx context.Context, in *pb.ListUsersTrashRequest) (*pb.ListUsersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	list, next, err := h.usersRepository.FindDeleted(
+		ctx, database.PageReq{Size: int(in.PageSize), Cursor: in.PageToken},
+	)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.ListUsersResponse{Users: usersToPB(list), NextPageToken: next}, nil
+}
+
+// newUserID пустой id заменяется UUIDv7: такие ключи растут со временем и не разбрасывают вставки по индексу
+func newUserID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.NewV7()
+	}
+
+	return uuid.Parse(id)
+}
+
+func (h Handler) hashPassword(password string) (string, error) {
+	if password == "" {
+		return "", domain.InvalidArgument("password", "must not be empty")
+	}
+
+	hash, err := h.passwordHasher.Hash(password)
+	if err != nil {
+		return "", domain.Internal(err)
+	}
+
+	return hash, nil
+}
+
+func userToPB(u database.User) *pb.User {
+	user := &pb.User{
+		Id:        u.ID.String(),
+		Username:  u.Username,
+		Role:      u.Role,
+		CreatedAt: u.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: u.UpdatedAt.Format(time.RFC3339),
+		Version:   u.Version,
+	}
+	if u.DeletedAt != nil {
+		user.DeletedAt = u.DeletedAt.Format(time.RFC3339)
+	}
+
+	return user
+}
 
-	for _, l := range list {
-		response = append(
-			response, &pb.User{
-				Id:        l.ID.String(),
-				Username:  l.Username,
-				Password:  l.Password,
-				CreatedAt: l.CreatedAt.Format(time.RFC3339),
-				UpdatedAt: l.UpdatedAt.Format(time.RFC3339),
-			},
-		)
+func usersToPB(list []database.User) []*pb.User {
+	users := make([]*pb.User, 0, len(list))
+	for _, u := range list {
+		users = append(users, userToPB(u))
 	}
 
-	return &pb.ListUsersResponse{Users: response}, nil
+	return users
 }
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'member';
+
+ALTER TABLE users
+    ADD CONSTRAINT users_role_check CHECK (role IN ('admin', 'member', 'read-only'));
+
+END;
\ No newline at end of file
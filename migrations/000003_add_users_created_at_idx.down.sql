@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS users_created_at_id_idx;
+
+END;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS users_created_at_id_idx ON users (created_at, id);
+
+END;
\ No newline at end of file
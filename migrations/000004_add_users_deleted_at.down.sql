@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP INDEX IF EXISTS users_deleted_at_idx;
+
+-- удаленные пользователи не переживают откат: иначе их имена нарушили бы ограничение уникальности
+DELETE FROM users WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS users_username_uniq_idx;
+ALTER TABLE users ADD CONSTRAINT users_username_uniq_idx UNIQUE (username);
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
+
+END;
\ No newline at end of file
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- имя освобождается вместе с удалением учетной записи, поэтому уникальность проверяется только среди живых
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_username_uniq_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS users_username_uniq_idx ON users (username) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS users_deleted_at_idx ON users (deleted_at) WHERE deleted_at IS NOT NULL;
+
+END;
\ No newline at end of file
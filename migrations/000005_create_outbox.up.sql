@@ -0,0 +1,20 @@
+BEGIN;
+
+-- события, которые нужно доставить в другие сервисы. Пишутся в одной транзакции с изменением, которое их вызвало,
+-- и удаляются после успешной доставки
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id              BIGSERIAL NOT NULL,
+    topic           TEXT      NOT NULL,
+    payload         JSONB     NOT NULL,
+    attempts        INT       NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_outbox_idx PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS outbox_next_attempt_at_idx ON outbox (next_attempt_at);
+
+END;
\ No newline at end of file
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- версия для оптимистичной блокировки: запись с устаревшей версией отклоняется
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+END;
\ No newline at end of file
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- ответы api-gw на запросы с Idempotency-Key. Пока запрос выполняется, status = 0. Истекшие записи удаляет api-gw
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    key         TEXT                     NOT NULL,
+    fingerprint TEXT                     NOT NULL,
+    status      INT                      NOT NULL DEFAULT 0,
+    header      JSONB,
+    body        BYTEA,
+    expires_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT pk_idempotency_keys_idx PRIMARY KEY (key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+END;
\ No newline at end of file
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS outbox_next_attempt_at_idx;
+CREATE INDEX IF NOT EXISTS outbox_next_attempt_at_idx ON outbox (next_attempt_at);
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS dead_at;
+
+END;
\ No newline at end of file
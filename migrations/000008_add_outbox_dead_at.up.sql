@@ -0,0 +1,10 @@
+BEGIN;
+
+-- события, которые не удалось доставить за отведенное число попыток. Relay их больше не выдает
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS dead_at TIMESTAMP WITH TIME ZONE;
+
+DROP INDEX IF EXISTS outbox_next_attempt_at_idx;
+CREATE INDEX IF NOT EXISTS outbox_next_attempt_at_idx ON outbox (next_attempt_at) WHERE dead_at IS NULL;
+
+END;
\ No newline at end of file
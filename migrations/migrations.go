@@ -0,0 +1,9 @@
+// Package migrations схема Postgres в формате golang-migrate: NNNNNN_name.up.sql и NNNNNN_name.down.sql. Кроме
+// таблиц users-srv здесь же таблица ключей идемпотентности api-gw. Файлы встраиваются в бинарник users-srv и
+// применяются пакетом migrator
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -15,76 +15,516 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for ErrorCode.
 const (
 	BadRequest          ErrorCode = "badRequest"
 	Conflict            ErrorCode = "conflict"
+	Forbidden           ErrorCode = "forbidden"
 	InternalServerError ErrorCode = "internalServerError"
 	NotFound            ErrorCode = "notFound"
+	PreconditionFailed  ErrorCode = "preconditionFailed"
+	Unauthorized        ErrorCode = "unauthorized"
+)
+
+// Defines values for ErrorDetailType.
+const (
+	ErrorInfo    ErrorDetailType = "error_info"
+	ResourceInfo ErrorDetailType = "resource_info"
+)
+
+// Defines values for ProfileErrorSource.
+const (
+	Links ProfileErrorSource = "links"
+	Users ProfileErrorSource = "users"
+)
+
+// Defines values for Role.
+const (
+	Admin    Role = "admin"
+	Member   Role = "member"
+	ReadOnly Role = "read-only"
+)
+
+// Defines values for GetLinksParamsTagMatch.
+const (
+	All GetLinksParamsTagMatch = "all"
+	Any GetLinksParamsTagMatch = "any"
+)
+
+// Defines values for GetLinksParamsOrder.
+const (
+	Asc       GetLinksParamsOrder = "asc"
+	Desc      GetLinksParamsOrder = "desc"
+	Relevance GetLinksParamsOrder = "relevance"
 )
 
+// DailyVisits defines model for DailyVisits.
+type DailyVisits struct {
+	// Date YYYY-MM-DD
+	Date           string `json:"date"`
+	UniqueVisitors int64  `json:"unique_visitors"`
+	Visits         int64  `json:"visits"`
+}
+
 // Error defines model for Error.
 type Error struct {
-	Code    ErrorCode `json:"code"`
-	Message *string   `json:"message,omitempty"`
+	Code ErrorCode `json:"code"`
+
+	// Details Машиночитаемые подробности ошибки из сервиса, обработавшего запрос
+	Details *[]ErrorDetail `json:"details,omitempty"`
+	Message *string        `json:"message,omitempty"`
+
+	// RequestId Идентификатор запроса, тот же, что в заголовке X-Request-Id
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Violations Поля запроса, не прошедшие проверку по спецификации или в сервисе
+	Violations *[]FieldViolation `json:"violations,omitempty"`
 }
 
 // ErrorCode defines model for Error.Code.
 type ErrorCode string
 
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// Domain Сервис, вернувший ошибку. Только для error_info
+	Domain   *string            `json:"domain,omitempty"`
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// Reason Причина ошибки в верхнем регистре, например VERSION_MISMATCH. Только для error_info
+	Reason *string `json:"reason,omitempty"`
+
+	// ResourceName Идентификатор записи. Только для resource_info
+	ResourceName *string `json:"resource_name,omitempty"`
+
+	// ResourceType Тип записи, например link. Только для resource_info
+	ResourceType *string         `json:"resource_type,omitempty"`
+	Type         ErrorDetailType `json:"type"`
+}
+
+// ErrorDetailType defines model for ErrorDetail.Type.
+type ErrorDetailType string
+
+// FieldViolation defines model for FieldViolation.
+type FieldViolation struct {
+	Description string `json:"description"`
+
+	// Field Имя параметра или путь к полю тела через точку, например images.0
+	Field string `json:"field"`
+}
+
 // Link defines model for Link.
 type Link struct {
-	CreatedAt string   `json:"created_at"`
-	Id        string   `json:"id"`
-	Images    []string `json:"images"`
+	CreatedAt string `json:"created_at"`
+
+	// DeletedAt Время удаления, только у ссылок из корзины
+	DeletedAt *string `json:"deleted_at,omitempty"`
+
+	// Description Описание страницы, заполняется автоматически вскоре после создания
+	Description *string `json:"description,omitempty"`
+
+	// Health Результат последней проверки доступности url, отсутствует, пока ссылку не проверяли
+	Health *LinkHealth `json:"health,omitempty"`
+
+	// Highlights Совпадения полнотекстового поиска. Значения экранированы как HTML, совпавшие слова обернуты в mark. Поля без совпадений отсутствуют
+	Highlights *LinkHighlights `json:"highlights,omitempty"`
+	Id         string          `json:"id"`
+	Images     []string        `json:"images"`
+
+	// Score Релевантность, только при поиске с параметром q
+	Score *float64 `json:"score,omitempty"`
+
+	// ShortCode Код для GET /r/{code}, отсутствует у ссылок, созданных до появления коротких кодов
+	ShortCode *string  `json:"short_code,omitempty"`
 	Tags      []string `json:"tags"`
 	Title     string   `json:"title"`
 	UpdatedAt string   `json:"updated_at"`
 	Url       string   `json:"url"`
 	UserId    string   `json:"user_id"`
+
+	// Version Растет при каждом изменении, в заголовке ETag передается в кавычках
+	Version int64 `json:"version"`
 }
 
-// LinkCreate defines model for LinkCreate.
+// LinkCreate Незаданные title, description и images после создания заполняются со страницы по url
 type LinkCreate struct {
-	Id     string   `json:"id"`
-	Images []string `json:"images"`
-	Tags   []string `json:"tags"`
-	Title  string   `json:"title"`
-	Url    string   `json:"url"`
-	UserId string   `json:"user_id"`
+	Description *string `json:"description,omitempty"`
+
+	// Id ObjectID ссылки. Если не задан, сервис сгенерирует новый. Задается при импорте, чтобы сохранить существующие идентификаторы
+	Id     *string   `json:"id,omitempty"`
+	Images *[]string `json:"images,omitempty"`
+
+	// ShortCode Пользовательский короткий код, 3-32 символа из латиницы, цифр, '-' и '_'. По умолчанию генерируется
+	ShortCode *string  `json:"short_code,omitempty"`
+	Tags      []string `json:"tags"`
+	Title     *string  `json:"title,omitempty"`
+	Url       string   `json:"url"`
+	UserId    string   `json:"user_id"`
+}
+
+// LinkHealth Результат последней проверки доступности url, отсутствует, пока ссылку не проверяли
+type LinkHealth struct {
+	CheckedAt string  `json:"checked_at"`
+	Error     *string `json:"error,omitempty"`
+
+	// FailureStreak Неудачных проверок подряд
+	FailureStreak int32 `json:"failure_streak"`
+
+	// RedirectUrl Итоговый url после редиректов, если он отличается
+	RedirectUrl *string `json:"redirect_url,omitempty"`
+
+	// StatusCode 0, если ответа не было
+	StatusCode int32 `json:"status_code"`
+}
+
+// LinkHighlights Совпадения полнотекстового поиска. Значения экранированы как HTML, совпавшие слова обернуты в mark. Поля без совпадений отсутствуют
+type LinkHighlights struct {
+	Tags  *[]string `json:"tags,omitempty"`
+	Title *string   `json:"title,omitempty"`
+	Url   *string   `json:"url,omitempty"`
+}
+
+// LinkList defines model for LinkList.
+type LinkList struct {
+	Links []Link `json:"links"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// LinkPatch defines model for LinkPatch.
+type LinkPatch struct {
+	Description *string   `json:"description"`
+	Images      *[]string `json:"images"`
+	Tags        *[]string `json:"tags"`
+	Title       *string   `json:"title"`
+	Url         *string   `json:"url,omitempty"`
+	UserId      *string   `json:"user_id,omitempty"`
+}
+
+// LinkStats defines model for LinkStats.
+type LinkStats struct {
+	// Daily По возрастанию дат в UTC, дни без переходов пропускаются
+	Daily       []DailyVisits `json:"daily"`
+	LinkId      string        `json:"link_id"`
+	TotalVisits int64         `json:"total_visits"`
+
+	// UniqueVisitors Уникальные посетители по хешу ip
+	UniqueVisitors int64 `json:"unique_visitors"`
+}
+
+// LinksSummary defines model for LinksSummary.
+type LinksSummary struct {
+	// Count Все ссылки пользователя, кроме лежащих в корзине
+	Count int64 `json:"count"`
+
+	// Recent Последние созданные ссылки, от новых к старым
+	Recent []Link `json:"recent"`
+
+	// TopTags По убыванию числа ссылок, при равенстве по имени тега
+	TopTags []TagCount `json:"top_tags"`
+}
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// ProfileError defines model for ProfileError.
+type ProfileError struct {
+	Error Error `json:"error"`
+
+	// Source Сервис, который не ответил
+	Source ProfileErrorSource `json:"source"`
+}
+
+// ProfileErrorSource Сервис, который не ответил
+type ProfileErrorSource string
+
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Role defines model for Role.
+type Role string
+
+// TagCount defines model for TagCount.
+type TagCount struct {
+	Count int64  `json:"count"`
+	Tag   string `json:"tag"`
+}
+
+// Token defines model for Token.
+type Token struct {
+	AccessToken string `json:"access_token"`
+
+	// ExpiresIn Время жизни access токена в секундах
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
 }
 
 // User defines model for User.
 type User struct {
 	CreatedAt string `json:"created_at"`
-	Id        string `json:"id"`
-	Password  string `json:"password"`
-	UpdatedAt string `json:"updated_at"`
-	Username  string `json:"username"`
+
+	// DeletedAt Время удаления, только у пользователей из корзины
+	DeletedAt *string `json:"deleted_at,omitempty"`
+	Id        string  `json:"id"`
+	Role      Role    `json:"role"`
+	UpdatedAt string  `json:"updated_at"`
+	Username  string  `json:"username"`
+
+	// Version Растет при каждом изменении, в заголовке ETag передается в кавычках
+	Version int64 `json:"version"`
 }
 
 // UserCreate defines model for UserCreate.
 type UserCreate struct {
-	Id       string `json:"id"`
-	Password string `json:"password"`
-	Username string `json:"username"`
+	// Id UUID пользователя. Если не задан, сервис сгенерирует UUIDv7. Задается при импорте, чтобы сохранить существующие идентификаторы, и только admin: иначе анонимный клиент мог бы занять чужой id
+	Id       *string `json:"id,omitempty"`
+	Password string  `json:"password"`
+	Role     *Role   `json:"role,omitempty"`
+	Username string  `json:"username"`
+}
+
+// UserList defines model for UserList.
+type UserList struct {
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Users      []User  `json:"users"`
+}
+
+// UserPatch defines model for UserPatch.
+type UserPatch struct {
+	Password *string `json:"password,omitempty"`
+	Role     *Role   `json:"role,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// UserProfile defines model for UserProfile.
+type UserProfile struct {
+	// Errors Части профиля, которые не удалось получить. Пустой массив означает полный профиль
+	Errors []ProfileError `json:"errors"`
+	Links  *LinksSummary  `json:"links,omitempty"`
+	User   *User          `json:"user,omitempty"`
+}
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// Limit defines model for Limit.
+type Limit = int32
+
+// Prefer defines model for Prefer.
+type Prefer = string
+
+// AccessDenied defines model for AccessDenied.
+type AccessDenied = Error
+
+// IdempotencyKeyMismatch defines model for IdempotencyKeyMismatch.
+type IdempotencyKeyMismatch = Error
+
+// Unauthenticated defines model for Unauthenticated.
+type Unauthenticated = Error
+
+// VersionMismatch defines model for VersionMismatch.
+type VersionMismatch = Error
+
+// GetLinksParams defines parameters for GetLinks.
+type GetLinksParams struct {
+	// Q Полнотекстовый поиск по title, url и tags. Фразы берутся в кавычки, минус исключает слово
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Tag Фильтр по тегам, параметр можно повторять
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// TagMatch any - достаточно одного тега, all - нужны все теги
+	TagMatch *GetLinksParamsTagMatch `form:"tag_match,omitempty" json:"tag_match,omitempty"`
+	UserId   *string                 `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// CreatedAfter Нижняя граница created_at, включительно
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Верхняя граница created_at, не включительно
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Order Порядок сортировки по created_at или по релевантности. С параметром q по умолчанию relevance
+	Order *GetLinksParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 50
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetLinksParamsTagMatch defines parameters for GetLinks.
+type GetLinksParamsTagMatch string
+
+// GetLinksParamsOrder defines parameters for GetLinks.
+type GetLinksParamsOrder string
+
+// PostLinksParams defines parameters for PostLinks.
+type PostLinksParams struct {
+	// IdempotencyKey Повтор запроса с тем же ключом и телом получает сохраненный ответ, а не выполняет запрос заново. Пока первый запрос выполняется, повтор получает 409, запрос с тем же ключом и другим телом, If-Match или Prefer получает 422. Ключ действует в пределах пользователя из токена, без токена он игнорируется. Ответ хранится ограниченное время, после него ключ можно использовать снова
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetLinksBrokenParams defines parameters for GetLinksBroken.
+type GetLinksBrokenParams struct {
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 50
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetLinksTrashParams defines parameters for GetLinksTrash.
+type GetLinksTrashParams struct {
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 50
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetLinksUserUserIDParams defines parameters for GetLinksUserUserID.
+type GetLinksUserUserIDParams struct {
+	// Limit Размер страницы, по умолчанию 50
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PatchLinksIdParams defines parameters for PatchLinksId.
+type PatchLinksIdParams struct {
+	// IfMatch ETag из GET. Если объект с тех пор изменился, запись отклоняется с 412, * и пустое значение снимают проверку
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// Prefer return=minimal возвращает 204 без тела, как до появления ответа с объектом. По умолчанию return=representation
+	Prefer *Prefer `json:"Prefer,omitempty"`
+}
+
+// PutLinksIdParams defines parameters for PutLinksId.
+type PutLinksIdParams struct {
+	// IfMatch ETag из GET. Если объект с тех пор изменился, запись отклоняется с 412, * и пустое значение снимают проверку
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// Prefer return=minimal возвращает 204 без тела, как до появления ответа с объектом. По умолчанию return=representation
+	Prefer *Prefer `json:"Prefer,omitempty"`
+
+	// IdempotencyKey Повтор запроса с тем же ключом и телом получает сохраненный ответ, а не выполняет запрос заново. Пока первый запрос выполняется, повтор получает 409, запрос с тем же ключом и другим телом, If-Match или Prefer получает 422. Ключ действует в пределах пользователя из токена, без токена он игнорируется. Ответ хранится ограниченное время, после него ключ можно использовать снова
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetLinksIdStatsParams defines parameters for GetLinksIdStats.
+type GetLinksIdStatsParams struct {
+	// From Начало периода, включительно
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода, не включительно
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	// Limit Размер страницы, по умолчанию 50
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostUsersParams defines parameters for PostUsers.
+type PostUsersParams struct {
+	// IdempotencyKey Повтор запроса с тем же ключом и телом получает сохраненный ответ, а не выполняет запрос заново. Пока первый запрос выполняется, повтор получает 409, запрос с тем же ключом и другим телом, If-Match или Prefer получает 422. Ключ действует в пределах пользователя из токена, без токена он игнорируется. Ответ хранится ограниченное время, после него ключ можно использовать снова
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUsersTrashParams defines parameters for GetUsersTrash.
+type GetUsersTrashParams struct {
+	// Limit Размер страницы, по умолчанию 50
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PatchUsersIdParams defines parameters for PatchUsersId.
+type PatchUsersIdParams struct {
+	// IfMatch ETag из GET. Если объект с тех пор изменился, запись отклоняется с 412, * и пустое значение снимают проверку
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// Prefer return=minimal возвращает 204 без тела, как до появления ответа с объектом. По умолчанию return=representation
+	Prefer *Prefer `json:"Prefer,omitempty"`
 }
 
+// PutUsersIdParams defines parameters for PutUsersId.
+type PutUsersIdParams struct {
+	// IfMatch ETag из GET. Если объект с тех пор изменился, запись отклоняется с 412, * и пустое значение снимают проверку
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// Prefer return=minimal возвращает 204 без тела, как до появления ответа с объектом. По умолчанию return=representation
+	Prefer *Prefer `json:"Prefer,omitempty"`
+
+	// IdempotencyKey Повтор запроса с тем же ключом и телом получает сохраненный ответ, а не выполняет запрос заново. Пока первый запрос выполняется, повтор получает 409, запрос с тем же ключом и другим телом, If-Match или Prefer получает 422. Ключ действует в пределах пользователя из токена, без токена он игнорируется. Ответ хранится ограниченное время, после него ключ можно использовать снова
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUsersIdProfileParams defines parameters for GetUsersIdProfile.
+type GetUsersIdProfileParams struct {
+	// Recent Сколько последних ссылок вернуть, по умолчанию 5
+	Recent *int32 `form:"recent,omitempty" json:"recent,omitempty"`
+
+	// TopTags Сколько самых частых тегов вернуть, по умолчанию 10
+	TopTags *int32 `form:"top_tags,omitempty" json:"top_tags,omitempty"`
+}
+
+// PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
+type PostAuthLoginJSONRequestBody = LoginRequest
+
+// PostAuthRefreshJSONRequestBody defines body for PostAuthRefresh for application/json ContentType.
+type PostAuthRefreshJSONRequestBody = RefreshRequest
+
 // PostLinksJSONRequestBody defines body for PostLinks for application/json ContentType.
 type PostLinksJSONRequestBody = LinkCreate
 
+// PatchLinksIdApplicationMergePatchPlusJSONRequestBody defines body for PatchLinksId for application/merge-patch+json ContentType.
+type PatchLinksIdApplicationMergePatchPlusJSONRequestBody = LinkPatch
+
 // PutLinksIdJSONRequestBody defines body for PutLinksId for application/json ContentType.
 type PutLinksIdJSONRequestBody = LinkCreate
 
 // PostUsersJSONRequestBody defines body for PostUsers for application/json ContentType.
 type PostUsersJSONRequestBody = UserCreate
 
+// PatchUsersIdApplicationMergePatchPlusJSONRequestBody defines body for PatchUsersId for application/merge-patch+json ContentType.
+type PatchUsersIdApplicationMergePatchPlusJSONRequestBody = UserPatch
+
 // PutUsersIdJSONRequestBody defines body for PutUsersId for application/json ContentType.
 type PutUsersIdJSONRequestBody = UserCreate
 
@@ -161,16 +601,32 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// PostAuthLoginWithBody request with any body
+	PostAuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthLogin(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthRefreshWithBody request with any body
+	PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLinks request
-	GetLinks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLinks(ctx context.Context, params *GetLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostLinksWithBody request with any body
-	PostLinksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostLinksWithBody(ctx context.Context, params *PostLinksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLinks(ctx context.Context, params *PostLinksParams, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostLinks(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetLinksBroken request
+	GetLinksBroken(ctx context.Context, params *GetLinksBrokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksTrash request
+	GetLinksTrash(ctx context.Context, params *GetLinksTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetLinksUserUserID request
-	GetLinksUserUserID(ctx context.Context, userID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLinksUserUserID(ctx context.Context, userID string, params *GetLinksUserUserIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteLinksId request
 	DeleteLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -178,18 +634,35 @@ type ClientInterface interface {
 	// GetLinksId request
 	GetLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchLinksIdWithBody request with any body
+	PatchLinksIdWithBody(ctx context.Context, id string, params *PatchLinksIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchLinksIdWithApplicationMergePatchPlusJSONBody(ctx context.Context, id string, params *PatchLinksIdParams, body PatchLinksIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutLinksIdWithBody request with any body
-	PutLinksIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutLinksIdWithBody(ctx context.Context, id string, params *PutLinksIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutLinksId(ctx context.Context, id string, params *PutLinksIdParams, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutLinksId(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostLinksIdRestore request
+	PostLinksIdRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksIdStats request
+	GetLinksIdStats(ctx context.Context, id string, params *GetLinksIdStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRCode request
+	GetRCode(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetUsers request
-	GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetUsers(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostUsersWithBody request with any body
-	PostUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostUsersWithBody(ctx context.Context, params *PostUsersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostUsers(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostUsers(ctx context.Context, params *PostUsersParams, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersTrash request
+	GetUsersTrash(ctx context.Context, params *GetUsersTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteUsersId request
 	DeleteUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -197,14 +670,25 @@ type ClientInterface interface {
 	// GetUsersId request
 	GetUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchUsersIdWithBody request with any body
+	PatchUsersIdWithBody(ctx context.Context, id string, params *PatchUsersIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchUsersIdWithApplicationMergePatchPlusJSONBody(ctx context.Context, id string, params *PatchUsersIdParams, body PatchUsersIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutUsersIdWithBody request with any body
-	PutUsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutUsersIdWithBody(ctx context.Context, id string, params *PutUsersIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutUsersId(ctx context.Context, id string, params *PutUsersIdParams, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutUsersId(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetUsersIdProfile request
+	GetUsersIdProfile(ctx context.Context, id string, params *GetUsersIdProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersIdRestore request
+	PostUsersIdRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetLinks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLinksRequest(c.Server)
+func (c *Client) PostAuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -215,8 +699,8 @@ func (c *Client) GetLinks(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLinksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostLinksRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostAuthLogin(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -227,8 +711,8 @@ func (c *Client) PostLinksWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLinks(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostLinksRequest(c.Server, body)
+func (c *Client) PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRefreshRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -239,8 +723,8 @@ func (c *Client) PostLinks(ctx context.Context, body PostLinksJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLinksUserUserID(ctx context.Context, userID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLinksUserUserIDRequest(c.Server, userID)
+func (c *Client) PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRefreshRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -251,8 +735,8 @@ func (c *Client) GetLinksUserUserID(ctx context.Context, userID string, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteLinksIdRequest(c.Server, id)
+func (c *Client) GetLinks(ctx context.Context, params *GetLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +747,8 @@ func (c *Client) DeleteLinksId(ctx context.Context, id string, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLinksIdRequest(c.Server, id)
+func (c *Client) PostLinksWithBody(ctx context.Context, params *PostLinksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +759,8 @@ func (c *Client) GetLinksId(ctx context.Context, id string, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutLinksIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutLinksIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) PostLinks(ctx context.Context, params *PostLinksParams, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -287,8 +771,8 @@ func (c *Client) PutLinksIdWithBody(ctx context.Context, id string, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutLinksId(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutLinksIdRequest(c.Server, id, body)
+func (c *Client) GetLinksBroken(ctx context.Context, params *GetLinksBrokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksBrokenRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -299,8 +783,8 @@ func (c *Client) PutLinksId(ctx context.Context, id string, body PutLinksIdJSONR
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersRequest(c.Server)
+func (c *Client) GetLinksTrash(ctx context.Context, params *GetLinksTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksTrashRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -311,8 +795,8 @@ func (c *Client) GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetLinksUserUserID(ctx context.Context, userID string, params *GetLinksUserUserIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksUserUserIDRequest(c.Server, userID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -323,8 +807,8 @@ func (c *Client) PostUsersWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsers(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersRequest(c.Server, body)
+func (c *Client) DeleteLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLinksIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -335,8 +819,8 @@ func (c *Client) PostUsers(ctx context.Context, body PostUsersJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUsersIdRequest(c.Server, id)
+func (c *Client) GetLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -347,8 +831,8 @@ func (c *Client) DeleteUsersId(ctx context.Context, id string, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersIdRequest(c.Server, id)
+func (c *Client) PatchLinksIdWithBody(ctx context.Context, id string, params *PatchLinksIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchLinksIdRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -359,8 +843,8 @@ func (c *Client) GetUsersId(ctx context.Context, id string, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutUsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutUsersIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) PatchLinksIdWithApplicationMergePatchPlusJSONBody(ctx context.Context, id string, params *PatchLinksIdParams, body PatchLinksIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchLinksIdRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -371,8 +855,8 @@ func (c *Client) PutUsersIdWithBody(ctx context.Context, id string, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutUsersId(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutUsersIdRequest(c.Server, id, body)
+func (c *Client) PutLinksIdWithBody(ctx context.Context, id string, params *PutLinksIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLinksIdRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -383,158 +867,219 @@ func (c *Client) PutUsersId(ctx context.Context, id string, body PutUsersIdJSONR
 	return c.Client.Do(req)
 }
 
-// NewGetLinksRequest generates requests for GetLinks
-func NewGetLinksRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutLinksId(ctx context.Context, id string, params *PutLinksIdParams, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLinksIdRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/links")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostLinksIdRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksIdRestoreRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostLinksRequest calls the generic PostLinks builder with application/json body
-func NewPostLinksRequest(server string, body PostLinksJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetLinksIdStats(ctx context.Context, id string, params *GetLinksIdStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdStatsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostLinksRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostLinksRequestWithBody generates requests for PostLinks with any type of body
-func NewPostLinksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetRCode(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRCodeRequest(c.Server, code)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/links")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetUsers(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetLinksUserUserIDRequest generates requests for GetLinksUserUserID
-func NewGetLinksUserUserIDRequest(server string, userID string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+func (c *Client) PostUsersWithBody(ctx context.Context, params *PostUsersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/links/user/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PostUsers(ctx context.Context, params *PostUsersParams, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetUsersTrash(ctx context.Context, params *GetUsersTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersTrashRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeleteUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteLinksIdRequest generates requests for DeleteLinksId
-func NewDeleteLinksIdRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchUsersIdWithBody(ctx context.Context, id string, params *PatchUsersIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUsersIdRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/links/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchUsersIdWithApplicationMergePatchPlusJSONBody(ctx context.Context, id string, params *PatchUsersIdParams, body PatchUsersIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUsersIdRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) PutUsersIdWithBody(ctx context.Context, id string, params *PutUsersIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersIdRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PutUsersId(ctx context.Context, id string, params *PutUsersIdParams, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersIdRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetLinksIdRequest generates requests for GetLinksId
-func NewGetLinksIdRequest(server string, id string) (*http.Request, error) {
-	var err error
+func (c *Client) GetUsersIdProfile(ctx context.Context, id string, params *GetUsersIdProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersIdProfileRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) PostUsersIdRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersIdRestoreRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// NewPostAuthLoginRequest calls the generic PostAuthLogin builder with application/json body
+func NewPostAuthLoginRequest(server string, body PostAuthLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthLoginRequestWithBody generates requests for PostAuthLogin with any type of body
+func NewPostAuthLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	operationPath := fmt.Sprintf("/auth/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -544,42 +1089,37 @@ func NewGetLinksIdRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewPutLinksIdRequest calls the generic PutLinksId builder with application/json body
-func NewPutLinksIdRequest(server string, id string, body PutLinksIdJSONRequestBody) (*http.Request, error) {
+// NewPostAuthRefreshRequest calls the generic PostAuthRefresh builder with application/json body
+func NewPostAuthRefreshRequest(server string, body PostAuthRefreshJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutLinksIdRequestWithBody(server, id, "application/json", bodyReader)
+	return NewPostAuthRefreshRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPutLinksIdRequestWithBody generates requests for PutLinksId with any type of body
-func NewPutLinksIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAuthRefreshRequestWithBody generates requests for PostAuthRefresh with any type of body
+func NewPostAuthRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	operationPath := fmt.Sprintf("/auth/refresh")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -589,7 +1129,7 @@ func NewPutLinksIdRequestWithBody(server string, id string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -599,8 +1139,8 @@ func NewPutLinksIdRequestWithBody(server string, id string, contentType string,
 	return req, nil
 }
 
-// NewGetUsersRequest generates requests for GetUsers
-func NewGetUsersRequest(server string) (*http.Request, error) {
+// NewGetLinksRequest generates requests for GetLinks
+func NewGetLinksRequest(server string, params *GetLinksParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -608,7 +1148,7 @@ func NewGetUsersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/links")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -618,6 +1158,156 @@ func NewGetUsersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TagMatch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_match", runtime.ParamLocationQuery, *params.TagMatch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_after", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_before", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -626,19 +1316,19 @@ func NewGetUsersRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPostUsersRequest calls the generic PostUsers builder with application/json body
-func NewPostUsersRequest(server string, body PostUsersJSONRequestBody) (*http.Request, error) {
+// NewPostLinksRequest calls the generic PostLinks builder with application/json body
+func NewPostLinksRequest(server string, params *PostLinksParams, body PostLinksJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostUsersRequestWithBody(server, "application/json", bodyReader)
+	return NewPostLinksRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPostUsersRequestWithBody generates requests for PostUsers with any type of body
-func NewPostUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostLinksRequestWithBody generates requests for PostLinks with any type of body
+func NewPostLinksRequestWithBody(server string, params *PostLinksParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -646,7 +1336,7 @@ func NewPostUsersRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/links")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -663,26 +1353,34 @@ func NewPostUsersRequestWithBody(server string, contentType string, body io.Read
 
 	req.Header.Add("Content-Type", contentType)
 
-	return req, nil
-}
+	if params != nil {
 
-// NewDeleteUsersIdRequest generates requests for DeleteUsersId
-func NewDeleteUsersIdRequest(server string, id string) (*http.Request, error) {
-	var err error
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
 
-	var pathParam0 string
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewGetLinksBrokenRequest generates requests for GetLinksBroken
+func NewGetLinksBrokenRequest(server string, params *GetLinksBrokenParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/links/broken")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -692,7 +1390,61 @@ func NewDeleteUsersIdRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -700,23 +1452,16 @@ func NewDeleteUsersIdRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetUsersIdRequest generates requests for GetUsersId
-func NewGetUsersIdRequest(server string, id string) (*http.Request, error) {
+// NewGetLinksTrashRequest generates requests for GetLinksTrash
+func NewGetLinksTrashRequest(server string, params *GetLinksTrashParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/links/trash")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -726,6 +1471,60 @@ func NewGetUsersIdRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -734,24 +1533,13 @@ func NewGetUsersIdRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPutUsersIdRequest calls the generic PutUsersId builder with application/json body
-func NewPutUsersIdRequest(server string, id string, body PutUsersIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutUsersIdRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewPutUsersIdRequestWithBody generates requests for PutUsersId with any type of body
-func NewPutUsersIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetLinksUserUserIDRequest generates requests for GetLinksUserUserID
+func NewGetLinksUserUserIDRequest(server string, userID string, params *GetLinksUserUserIDParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -761,7 +1549,7 @@ func NewPutUsersIdRequestWithBody(server string, id string, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/links/user/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -771,1018 +1559,4052 @@ func NewPutUsersIdRequestWithBody(server string, id string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewDeleteLinksIdRequest generates requests for DeleteLinksId
+func NewDeleteLinksIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewGetLinksIdRequest generates requests for GetLinksId
+func NewGetLinksIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetLinksWithResponse request
-	GetLinksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLinksResponse, error)
+// NewPatchLinksIdRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchLinksId builder with application/merge-patch+json body
+func NewPatchLinksIdRequestWithApplicationMergePatchPlusJSONBody(server string, id string, params *PatchLinksIdParams, body PatchLinksIdApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchLinksIdRequestWithBody(server, id, params, "application/merge-patch+json", bodyReader)
+}
 
-	// PostLinksWithBodyWithResponse request with any body
-	PostLinksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksResponse, error)
+// NewPatchLinksIdRequestWithBody generates requests for PatchLinksId with any type of body
+func NewPatchLinksIdRequestWithBody(server string, id string, params *PatchLinksIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	PostLinksWithResponse(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksResponse, error)
+	var pathParam0 string
 
-	// GetLinksUserUserIDWithResponse request
-	GetLinksUserUserIDWithResponse(ctx context.Context, userID string, reqEditors ...RequestEditorFn) (*GetLinksUserUserIDResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteLinksIdWithResponse request
-	DeleteLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteLinksIdResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetLinksIdWithResponse request
-	GetLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetLinksIdResponse, error)
+	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PutLinksIdWithBodyWithResponse request with any body
-	PutLinksIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PutLinksIdWithResponse(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetUsersWithResponse request
-	GetUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PostUsersWithBodyWithResponse request with any body
-	PostUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersResponse, error)
+	if params != nil {
 
-	PostUsersWithResponse(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersResponse, error)
+		if params.IfMatch != nil {
+			var headerParam0 string
 
-	// DeleteUsersIdWithResponse request
-	DeleteUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteUsersIdResponse, error)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
 
-	// GetUsersIdWithResponse request
-	GetUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdResponse, error)
+			req.Header.Set("If-Match", headerParam0)
+		}
 
-	// PutUsersIdWithBodyWithResponse request with any body
-	PutUsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error)
+		if params.Prefer != nil {
+			var headerParam1 string
 
-	PutUsersIdWithResponse(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error)
-}
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
 
-type GetLinksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Link
-	JSON400      *Error
-	JSON500      *Error
-}
+			req.Header.Set("Prefer", headerParam1)
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetLinksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLinksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPutLinksIdRequest calls the generic PutLinksId builder with application/json body
+func NewPutLinksIdRequest(server string, id string, params *PutLinksIdParams, body PutLinksIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPutLinksIdRequestWithBody(server, id, params, "application/json", bodyReader)
 }
 
-type PostLinksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
-}
+// NewPutLinksIdRequestWithBody generates requests for PutLinksId with any type of body
+func NewPutLinksIdRequestWithBody(server string, id string, params *PutLinksIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostLinksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostLinksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetLinksUserUserIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Link
-	JSON400      *Error
-	JSON404      *Error
-}
+	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetLinksUserUserIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLinksUserUserIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteLinksIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-}
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+		if params.Prefer != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam1)
+		}
+
+		if params.IdempotencyKey != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam2)
+		}
 
-// Status returns HTTPResponse.Status
-func (r DeleteLinksIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteLinksIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostLinksIdRestoreRequest generates requests for PostLinksIdRestore
+func NewPostLinksIdRestoreRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetLinksIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Link
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLinksIdStatsRequest generates requests for GetLinksIdStats
+func NewGetLinksIdStatsRequest(server string, id string, params *GetLinksIdStatsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRCodeRequest generates requests for GetRCode
+func NewGetRCodeRequest(server string, code string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/r/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersRequest generates requests for GetUsers
+func NewGetUsersRequest(server string, params *GetUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersRequest calls the generic PostUsers builder with application/json body
+func NewPostUsersRequest(server string, params *PostUsersParams, body PostUsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostUsersRequestWithBody generates requests for PostUsers with any type of body
+func NewPostUsersRequestWithBody(server string, params *PostUsersParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsersTrashRequest generates requests for GetUsersTrash
+func NewGetUsersTrashRequest(server string, params *GetUsersTrashParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUsersIdRequest generates requests for DeleteUsersId
+func NewDeleteUsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersIdRequest generates requests for GetUsersId
+func NewGetUsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchUsersIdRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchUsersId builder with application/merge-patch+json body
+func NewPatchUsersIdRequestWithApplicationMergePatchPlusJSONBody(server string, id string, params *PatchUsersIdParams, body PatchUsersIdApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchUsersIdRequestWithBody(server, id, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchUsersIdRequestWithBody generates requests for PatchUsersId with any type of body
+func NewPatchUsersIdRequestWithBody(server string, id string, params *PatchUsersIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+		if params.Prefer != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutUsersIdRequest calls the generic PutUsersId builder with application/json body
+func NewPutUsersIdRequest(server string, id string, params *PutUsersIdParams, body PutUsersIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsersIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutUsersIdRequestWithBody generates requests for PutUsersId with any type of body
+func NewPutUsersIdRequestWithBody(server string, id string, params *PutUsersIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+		if params.Prefer != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam1)
+		}
+
+		if params.IdempotencyKey != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsersIdProfileRequest generates requests for GetUsersIdProfile
+func NewGetUsersIdProfileRequest(server string, id string, params *GetUsersIdProfileParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/profile", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Recent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recent", runtime.ParamLocationQuery, *params.Recent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TopTags != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "top_tags", runtime.ParamLocationQuery, *params.TopTags); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersIdRestoreRequest generates requests for PostUsersIdRestore
+func NewPostUsersIdRestoreRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostAuthLoginWithBodyWithResponse request with any body
+	PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+
+	PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+
+	// PostAuthRefreshWithBodyWithResponse request with any body
+	PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
+	PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
+	// GetLinksWithResponse request
+	GetLinksWithResponse(ctx context.Context, params *GetLinksParams, reqEditors ...RequestEditorFn) (*GetLinksResponse, error)
+
+	// PostLinksWithBodyWithResponse request with any body
+	PostLinksWithBodyWithResponse(ctx context.Context, params *PostLinksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksResponse, error)
+
+	PostLinksWithResponse(ctx context.Context, params *PostLinksParams, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksResponse, error)
+
+	// GetLinksBrokenWithResponse request
+	GetLinksBrokenWithResponse(ctx context.Context, params *GetLinksBrokenParams, reqEditors ...RequestEditorFn) (*GetLinksBrokenResponse, error)
+
+	// GetLinksTrashWithResponse request
+	GetLinksTrashWithResponse(ctx context.Context, params *GetLinksTrashParams, reqEditors ...RequestEditorFn) (*GetLinksTrashResponse, error)
+
+	// GetLinksUserUserIDWithResponse request
+	GetLinksUserUserIDWithResponse(ctx context.Context, userID string, params *GetLinksUserUserIDParams, reqEditors ...RequestEditorFn) (*GetLinksUserUserIDResponse, error)
+
+	// DeleteLinksIdWithResponse request
+	DeleteLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteLinksIdResponse, error)
+
+	// GetLinksIdWithResponse request
+	GetLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetLinksIdResponse, error)
+
+	// PatchLinksIdWithBodyWithResponse request with any body
+	PatchLinksIdWithBodyWithResponse(ctx context.Context, id string, params *PatchLinksIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchLinksIdResponse, error)
+
+	PatchLinksIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id string, params *PatchLinksIdParams, body PatchLinksIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchLinksIdResponse, error)
+
+	// PutLinksIdWithBodyWithResponse request with any body
+	PutLinksIdWithBodyWithResponse(ctx context.Context, id string, params *PutLinksIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error)
+
+	PutLinksIdWithResponse(ctx context.Context, id string, params *PutLinksIdParams, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error)
+
+	// PostLinksIdRestoreWithResponse request
+	PostLinksIdRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostLinksIdRestoreResponse, error)
+
+	// GetLinksIdStatsWithResponse request
+	GetLinksIdStatsWithResponse(ctx context.Context, id string, params *GetLinksIdStatsParams, reqEditors ...RequestEditorFn) (*GetLinksIdStatsResponse, error)
+
+	// GetRCodeWithResponse request
+	GetRCodeWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*GetRCodeResponse, error)
+
+	// GetUsersWithResponse request
+	GetUsersWithResponse(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*GetUsersResponse, error)
+
+	// PostUsersWithBodyWithResponse request with any body
+	PostUsersWithBodyWithResponse(ctx context.Context, params *PostUsersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersResponse, error)
+
+	PostUsersWithResponse(ctx context.Context, params *PostUsersParams, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersResponse, error)
+
+	// GetUsersTrashWithResponse request
+	GetUsersTrashWithResponse(ctx context.Context, params *GetUsersTrashParams, reqEditors ...RequestEditorFn) (*GetUsersTrashResponse, error)
+
+	// DeleteUsersIdWithResponse request
+	DeleteUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteUsersIdResponse, error)
+
+	// GetUsersIdWithResponse request
+	GetUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdResponse, error)
+
+	// PatchUsersIdWithBodyWithResponse request with any body
+	PatchUsersIdWithBodyWithResponse(ctx context.Context, id string, params *PatchUsersIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsersIdResponse, error)
+
+	PatchUsersIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id string, params *PatchUsersIdParams, body PatchUsersIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsersIdResponse, error)
+
+	// PutUsersIdWithBodyWithResponse request with any body
+	PutUsersIdWithBodyWithResponse(ctx context.Context, id string, params *PutUsersIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error)
+
+	PutUsersIdWithResponse(ctx context.Context, id string, params *PutUsersIdParams, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error)
+
+	// GetUsersIdProfileWithResponse request
+	GetUsersIdProfileWithResponse(ctx context.Context, id string, params *GetUsersIdProfileParams, reqEditors ...RequestEditorFn) (*GetUsersIdProfileResponse, error)
+
+	// PostUsersIdRestoreWithResponse request
+	PostUsersIdRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostUsersIdRestoreResponse, error)
+}
+
+type PostAuthLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Token
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Token
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkList
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Link
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON409      *Error
+	JSON422      *IdempotencyKeyMismatch
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksBrokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkList
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksBrokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksBrokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkList
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksUserUserIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkList
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksUserUserIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksUserUserIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLinksIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLinksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLinksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Link
+	JSON401      *Unauthenticated
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchLinksIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Link
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON404      *Error
+	JSON409      *Error
+	JSON412      *VersionMismatch
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchLinksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchLinksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutLinksIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Link
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON404      *Error
+	JSON409      *Error
+	JSON412      *VersionMismatch
+	JSON422      *IdempotencyKeyMismatch
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutLinksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutLinksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLinksIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Link
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLinksIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLinksIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkStats
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+	JSON400      *Error
+	JSON403      *AccessDenied
+	JSON409      *Error
+	JSON422      *IdempotencyKeyMismatch
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON401      *Unauthenticated
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON404      *Error
+	JSON412      *VersionMismatch
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *Error
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
+	JSON404      *Error
+	JSON409      *Error
+	JSON412      *VersionMismatch
+	JSON422      *IdempotencyKeyMismatch
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersIdProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserProfile
+	JSON401      *Unauthenticated
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersIdProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersIdProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON401      *Unauthenticated
+	JSON403      *AccessDenied
 	JSON404      *Error
+	JSON409      *Error
 	JSON500      *Error
 }
 
-// Status returns HTTPResponse.Status
-func (r GetLinksIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// Status returns HTTPResponse.Status
+func (r PostUsersIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostAuthLoginWithBodyWithResponse request with arbitrary body returning *PostAuthLoginResponse
+func (c *ClientWithResponses) PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
+	rsp, err := c.PostAuthLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
+	rsp, err := c.PostAuthLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLoginResponse(rsp)
+}
+
+// PostAuthRefreshWithBodyWithResponse request with arbitrary body returning *PostAuthRefreshResponse
+func (c *ClientWithResponses) PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshResponse(rsp)
+}
+
+// GetLinksWithResponse request returning *GetLinksResponse
+func (c *ClientWithResponses) GetLinksWithResponse(ctx context.Context, params *GetLinksParams, reqEditors ...RequestEditorFn) (*GetLinksResponse, error) {
+	rsp, err := c.GetLinks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksResponse(rsp)
+}
+
+// PostLinksWithBodyWithResponse request with arbitrary body returning *PostLinksResponse
+func (c *ClientWithResponses) PostLinksWithBodyWithResponse(ctx context.Context, params *PostLinksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksResponse, error) {
+	rsp, err := c.PostLinksWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLinksWithResponse(ctx context.Context, params *PostLinksParams, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksResponse, error) {
+	rsp, err := c.PostLinks(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksResponse(rsp)
+}
+
+// GetLinksBrokenWithResponse request returning *GetLinksBrokenResponse
+func (c *ClientWithResponses) GetLinksBrokenWithResponse(ctx context.Context, params *GetLinksBrokenParams, reqEditors ...RequestEditorFn) (*GetLinksBrokenResponse, error) {
+	rsp, err := c.GetLinksBroken(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksBrokenResponse(rsp)
+}
+
+// GetLinksTrashWithResponse request returning *GetLinksTrashResponse
+func (c *ClientWithResponses) GetLinksTrashWithResponse(ctx context.Context, params *GetLinksTrashParams, reqEditors ...RequestEditorFn) (*GetLinksTrashResponse, error) {
+	rsp, err := c.GetLinksTrash(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksTrashResponse(rsp)
+}
+
+// GetLinksUserUserIDWithResponse request returning *GetLinksUserUserIDResponse
+func (c *ClientWithResponses) GetLinksUserUserIDWithResponse(ctx context.Context, userID string, params *GetLinksUserUserIDParams, reqEditors ...RequestEditorFn) (*GetLinksUserUserIDResponse, error) {
+	rsp, err := c.GetLinksUserUserID(ctx, userID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksUserUserIDResponse(rsp)
+}
+
+// DeleteLinksIdWithResponse request returning *DeleteLinksIdResponse
+func (c *ClientWithResponses) DeleteLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteLinksIdResponse, error) {
+	rsp, err := c.DeleteLinksId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLinksIdResponse(rsp)
+}
+
+// GetLinksIdWithResponse request returning *GetLinksIdResponse
+func (c *ClientWithResponses) GetLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetLinksIdResponse, error) {
+	rsp, err := c.GetLinksId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdResponse(rsp)
+}
+
+// PatchLinksIdWithBodyWithResponse request with arbitrary body returning *PatchLinksIdResponse
+func (c *ClientWithResponses) PatchLinksIdWithBodyWithResponse(ctx context.Context, id string, params *PatchLinksIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchLinksIdResponse, error) {
+	rsp, err := c.PatchLinksIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchLinksIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchLinksIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id string, params *PatchLinksIdParams, body PatchLinksIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchLinksIdResponse, error) {
+	rsp, err := c.PatchLinksIdWithApplicationMergePatchPlusJSONBody(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchLinksIdResponse(rsp)
+}
+
+// PutLinksIdWithBodyWithResponse request with arbitrary body returning *PutLinksIdResponse
+func (c *ClientWithResponses) PutLinksIdWithBodyWithResponse(ctx context.Context, id string, params *PutLinksIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error) {
+	rsp, err := c.PutLinksIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLinksIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutLinksIdWithResponse(ctx context.Context, id string, params *PutLinksIdParams, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error) {
+	rsp, err := c.PutLinksId(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLinksIdResponse(rsp)
+}
+
+// PostLinksIdRestoreWithResponse request returning *PostLinksIdRestoreResponse
+func (c *ClientWithResponses) PostLinksIdRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostLinksIdRestoreResponse, error) {
+	rsp, err := c.PostLinksIdRestore(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksIdRestoreResponse(rsp)
+}
+
+// GetLinksIdStatsWithResponse request returning *GetLinksIdStatsResponse
+func (c *ClientWithResponses) GetLinksIdStatsWithResponse(ctx context.Context, id string, params *GetLinksIdStatsParams, reqEditors ...RequestEditorFn) (*GetLinksIdStatsResponse, error) {
+	rsp, err := c.GetLinksIdStats(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdStatsResponse(rsp)
+}
+
+// GetRCodeWithResponse request returning *GetRCodeResponse
+func (c *ClientWithResponses) GetRCodeWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*GetRCodeResponse, error) {
+	rsp, err := c.GetRCode(ctx, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRCodeResponse(rsp)
+}
+
+// GetUsersWithResponse request returning *GetUsersResponse
+func (c *ClientWithResponses) GetUsersWithResponse(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*GetUsersResponse, error) {
+	rsp, err := c.GetUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersResponse(rsp)
+}
+
+// PostUsersWithBodyWithResponse request with arbitrary body returning *PostUsersResponse
+func (c *ClientWithResponses) PostUsersWithBodyWithResponse(ctx context.Context, params *PostUsersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersResponse, error) {
+	rsp, err := c.PostUsersWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersWithResponse(ctx context.Context, params *PostUsersParams, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersResponse, error) {
+	rsp, err := c.PostUsers(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersResponse(rsp)
+}
+
+// GetUsersTrashWithResponse request returning *GetUsersTrashResponse
+func (c *ClientWithResponses) GetUsersTrashWithResponse(ctx context.Context, params *GetUsersTrashParams, reqEditors ...RequestEditorFn) (*GetUsersTrashResponse, error) {
+	rsp, err := c.GetUsersTrash(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersTrashResponse(rsp)
+}
+
+// DeleteUsersIdWithResponse request returning *DeleteUsersIdResponse
+func (c *ClientWithResponses) DeleteUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteUsersIdResponse, error) {
+	rsp, err := c.DeleteUsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersIdResponse(rsp)
+}
+
+// GetUsersIdWithResponse request returning *GetUsersIdResponse
+func (c *ClientWithResponses) GetUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdResponse, error) {
+	rsp, err := c.GetUsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersIdResponse(rsp)
+}
+
+// PatchUsersIdWithBodyWithResponse request with arbitrary body returning *PatchUsersIdResponse
+func (c *ClientWithResponses) PatchUsersIdWithBodyWithResponse(ctx context.Context, id string, params *PatchUsersIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsersIdResponse, error) {
+	rsp, err := c.PatchUsersIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUsersIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchUsersIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id string, params *PatchUsersIdParams, body PatchUsersIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsersIdResponse, error) {
+	rsp, err := c.PatchUsersIdWithApplicationMergePatchPlusJSONBody(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUsersIdResponse(rsp)
+}
+
+// PutUsersIdWithBodyWithResponse request with arbitrary body returning *PutUsersIdResponse
+func (c *ClientWithResponses) PutUsersIdWithBodyWithResponse(ctx context.Context, id string, params *PutUsersIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error) {
+	rsp, err := c.PutUsersIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutUsersIdWithResponse(ctx context.Context, id string, params *PutUsersIdParams, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error) {
+	rsp, err := c.PutUsersId(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersIdResponse(rsp)
+}
+
+// GetUsersIdProfileWithResponse request returning *GetUsersIdProfileResponse
+func (c *ClientWithResponses) GetUsersIdProfileWithResponse(ctx context.Context, id string, params *GetUsersIdProfileParams, reqEditors ...RequestEditorFn) (*GetUsersIdProfileResponse, error) {
+	rsp, err := c.GetUsersIdProfile(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersIdProfileResponse(rsp)
+}
+
+// PostUsersIdRestoreWithResponse request returning *PostUsersIdRestoreResponse
+func (c *ClientWithResponses) PostUsersIdRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostUsersIdRestoreResponse, error) {
+	rsp, err := c.PostUsersIdRestore(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersIdRestoreResponse(rsp)
+}
+
+// ParsePostAuthLoginResponse parses an HTTP response from a PostAuthLoginWithResponse call
+func ParsePostAuthLoginResponse(rsp *http.Response) (*PostAuthLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Token
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthRefreshResponse parses an HTTP response from a PostAuthRefreshWithResponse call
+func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Token
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksResponse parses an HTTP response from a GetLinksWithResponse call
+func ParseGetLinksResponse(rsp *http.Response) (*GetLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLinksResponse parses an HTTP response from a PostLinksWithResponse call
+func ParsePostLinksResponse(rsp *http.Response) (*PostLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyMismatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksBrokenResponse parses an HTTP response from a GetLinksBrokenWithResponse call
+func ParseGetLinksBrokenResponse(rsp *http.Response) (*GetLinksBrokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksBrokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksTrashResponse parses an HTTP response from a GetLinksTrashWithResponse call
+func ParseGetLinksTrashResponse(rsp *http.Response) (*GetLinksTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksUserUserIDResponse parses an HTTP response from a GetLinksUserUserIDWithResponse call
+func ParseGetLinksUserUserIDResponse(rsp *http.Response) (*GetLinksUserUserIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksUserUserIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteLinksIdResponse parses an HTTP response from a DeleteLinksIdWithResponse call
+func ParseDeleteLinksIdResponse(rsp *http.Response) (*DeleteLinksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteLinksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdResponse parses an HTTP response from a GetLinksIdWithResponse call
+func ParseGetLinksIdResponse(rsp *http.Response) (*GetLinksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchLinksIdResponse parses an HTTP response from a PatchLinksIdWithResponse call
+func ParsePatchLinksIdResponse(rsp *http.Response) (*PatchLinksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchLinksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest VersionMismatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutLinksIdResponse parses an HTTP response from a PutLinksIdWithResponse call
+func ParsePutLinksIdResponse(rsp *http.Response) (*PutLinksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutLinksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest VersionMismatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyMismatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLinksIdRestoreResponse parses an HTTP response from a PostLinksIdRestoreWithResponse call
+func ParsePostLinksIdRestoreResponse(rsp *http.Response) (*PostLinksIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLinksIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdStatsResponse parses an HTTP response from a GetLinksIdStatsWithResponse call
+func ParseGetLinksIdStatsResponse(rsp *http.Response) (*GetLinksIdStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRCodeResponse parses an HTTP response from a GetRCodeWithResponse call
+func ParseGetRCodeResponse(rsp *http.Response) (*GetRCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
+func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersResponse parses an HTTP response from a PostUsersWithResponse call
+func ParsePostUsersResponse(rsp *http.Response) (*PostUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyMismatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersTrashResponse parses an HTTP response from a GetUsersTrashWithResponse call
+func ParseGetUsersTrashResponse(rsp *http.Response) (*GetUsersTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersIdResponse parses an HTTP response from a DeleteUsersIdWithResponse call
+func ParseDeleteUsersIdResponse(rsp *http.Response) (*DeleteUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersIdResponse parses an HTTP response from a GetUsersIdWithResponse call
+func ParseGetUsersIdResponse(rsp *http.Response) (*GetUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchUsersIdResponse parses an HTTP response from a PatchUsersIdWithResponse call
+func ParsePatchUsersIdResponse(rsp *http.Response) (*PatchUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest VersionMismatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutUsersIdResponse parses an HTTP response from a PutUsersIdWithResponse call
+func ParsePutUsersIdResponse(rsp *http.Response) (*PutUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest VersionMismatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyMismatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersIdProfileResponse parses an HTTP response from a GetUsersIdProfileWithResponse call
+func ParseGetUsersIdProfileResponse(rsp *http.Response) (*GetUsersIdProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersIdProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserProfile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersIdRestoreResponse parses an HTTP response from a PostUsersIdRestoreWithResponse call
+func ParsePostUsersIdRestoreResponse(rsp *http.Response) (*PostUsersIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthenticated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest AccessDenied
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Войти по логину и паролю и получить пару токенов
+	// (POST /auth/login)
+	PostAuthLogin(w http.ResponseWriter, r *http.Request)
+	// Обменять refresh токен на новую пару токенов
+	// (POST /auth/refresh)
+	PostAuthRefresh(w http.ResponseWriter, r *http.Request)
+	// Получить объекты Link постранично с фильтрами и полнотекстовым поиском
+	// (GET /links)
+	GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams)
+	// Создать новый объект Link
+	// (POST /links)
+	PostLinks(w http.ResponseWriter, r *http.Request, params PostLinksParams)
+	// Получить битые ссылки, которые не открылись несколько проверок подряд
+	// (GET /links/broken)
+	GetLinksBroken(w http.ResponseWriter, r *http.Request, params GetLinksBrokenParams)
+	// Получить удаленные ссылки из корзины постранично
+	// (GET /links/trash)
+	GetLinksTrash(w http.ResponseWriter, r *http.Request, params GetLinksTrashParams)
+	// Получить ссылки, связанные с пользователем
+	// (GET /links/user/{userID})
+	GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string, params GetLinksUserUserIDParams)
+	// Удалить объект Link по ID
+	// (DELETE /links/{id})
+	DeleteLinksId(w http.ResponseWriter, r *http.Request, id string)
+	// Получить объект Link по ID
+	// (GET /links/{id})
+	GetLinksId(w http.ResponseWriter, r *http.Request, id string)
+	// Частично обновить объект Link по ID
+	// (PATCH /links/{id})
+	PatchLinksId(w http.ResponseWriter, r *http.Request, id string, params PatchLinksIdParams)
+	// Обновить объект Link по ID
+	// (PUT /links/{id})
+	PutLinksId(w http.ResponseWriter, r *http.Request, id string, params PutLinksIdParams)
+	// Восстановить ссылку из корзины
+	// (POST /links/{id}/restore)
+	PostLinksIdRestore(w http.ResponseWriter, r *http.Request, id string)
+	// Получить статистику переходов по короткой ссылке. Доступна владельцу ссылки и admin
+	// (GET /links/{id}/stats)
+	GetLinksIdStats(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdStatsParams)
+	// Перейти по короткой ссылке
+	// (GET /r/{code})
+	GetRCode(w http.ResponseWriter, r *http.Request, code string)
+	// Получить пользователей постранично
+	// (GET /users)
+	GetUsers(w http.ResponseWriter, r *http.Request, params GetUsersParams)
+	// Создать нового пользователя
+	// (POST /users)
+	PostUsers(w http.ResponseWriter, r *http.Request, params PostUsersParams)
+	// Получить удаленных пользователей из корзины постранично
+	// (GET /users/trash)
+	GetUsersTrash(w http.ResponseWriter, r *http.Request, params GetUsersTrashParams)
+	// Удалить пользователя по ID
+	// (DELETE /users/{id})
+	DeleteUsersId(w http.ResponseWriter, r *http.Request, id string)
+	// Получить пользователя по ID
+	// (GET /users/{id})
+	GetUsersId(w http.ResponseWriter, r *http.Request, id string)
+	// Частично обновить пользователя по ID
+	// (PATCH /users/{id})
+	PatchUsersId(w http.ResponseWriter, r *http.Request, id string, params PatchUsersIdParams)
+	// Обновить пользователя по ID
+	// (PUT /users/{id})
+	PutUsersId(w http.ResponseWriter, r *http.Request, id string, params PutUsersIdParams)
+	// Профиль пользователя вместе со сводкой по его ссылкам
+	// (GET /users/{id}/profile)
+	GetUsersIdProfile(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdProfileParams)
+	// Восстановить пользователя из корзины
+	// (POST /users/{id}/restore)
+	PostUsersIdRestore(w http.ResponseWriter, r *http.Request, id string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Войти по логину и паролю и получить пару токенов
+// (POST /auth/login)
+func (_ Unimplemented) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обменять refresh токен на новую пару токенов
+// (POST /auth/refresh)
+func (_ Unimplemented) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить объекты Link постранично с фильтрами и полнотекстовым поиском
+// (GET /links)
+func (_ Unimplemented) GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать новый объект Link
+// (POST /links)
+func (_ Unimplemented) PostLinks(w http.ResponseWriter, r *http.Request, params PostLinksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить битые ссылки, которые не открылись несколько проверок подряд
+// (GET /links/broken)
+func (_ Unimplemented) GetLinksBroken(w http.ResponseWriter, r *http.Request, params GetLinksBrokenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить удаленные ссылки из корзины постранично
+// (GET /links/trash)
+func (_ Unimplemented) GetLinksTrash(w http.ResponseWriter, r *http.Request, params GetLinksTrashParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить ссылки, связанные с пользователем
+// (GET /links/user/{userID})
+func (_ Unimplemented) GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string, params GetLinksUserUserIDParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить объект Link по ID
+// (DELETE /links/{id})
+func (_ Unimplemented) DeleteLinksId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить объект Link по ID
+// (GET /links/{id})
+func (_ Unimplemented) GetLinksId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Частично обновить объект Link по ID
+// (PATCH /links/{id})
+func (_ Unimplemented) PatchLinksId(w http.ResponseWriter, r *http.Request, id string, params PatchLinksIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLinksIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Обновить объект Link по ID
+// (PUT /links/{id})
+func (_ Unimplemented) PutLinksId(w http.ResponseWriter, r *http.Request, id string, params PutLinksIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PutLinksIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+// Восстановить ссылку из корзины
+// (POST /links/{id}/restore)
+func (_ Unimplemented) PostLinksIdRestore(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Status returns HTTPResponse.Status
-func (r PutLinksIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// Получить статистику переходов по короткой ссылке. Доступна владельцу ссылки и admin
+// (GET /links/{id}/stats)
+func (_ Unimplemented) GetLinksIdStats(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutLinksIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Перейти по короткой ссылке
+// (GET /r/{code})
+func (_ Unimplemented) GetRCode(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]User
-	JSON400      *Error
-	JSON500      *Error
+// Получить пользователей постранично
+// (GET /users)
+func (_ Unimplemented) GetUsers(w http.ResponseWriter, r *http.Request, params GetUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// Создать нового пользователя
+// (POST /users)
+func (_ Unimplemented) PostUsers(w http.ResponseWriter, r *http.Request, params PostUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Получить удаленных пользователей из корзины постранично
+// (GET /users/trash)
+func (_ Unimplemented) GetUsersTrash(w http.ResponseWriter, r *http.Request, params GetUsersTrashParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
+// Удалить пользователя по ID
+// (DELETE /users/{id})
+func (_ Unimplemented) DeleteUsersId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// Получить пользователя по ID
+// (GET /users/{id})
+func (_ Unimplemented) GetUsersId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Частично обновить пользователя по ID
+// (PATCH /users/{id})
+func (_ Unimplemented) PatchUsersId(w http.ResponseWriter, r *http.Request, id string, params PatchUsersIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteUsersIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON500      *Error
+// Обновить пользователя по ID
+// (PUT /users/{id})
+func (_ Unimplemented) PutUsersId(w http.ResponseWriter, r *http.Request, id string, params PutUsersIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteUsersIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// Профиль пользователя вместе со сводкой по его ссылкам
+// (GET /users/{id}/profile)
+func (_ Unimplemented) GetUsersIdProfile(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdProfileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUsersIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Восстановить пользователя из корзины
+// (POST /users/{id}/restore)
+func (_ Unimplemented) PostUsersIdRestore(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetUsersIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON404      *Error
-	JSON500      *Error
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsersIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+type MiddlewareFunc func(http.Handler) http.Handler
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type PutUsersIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r PutUsersIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostAuthRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutUsersIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
 	}
-	return 0
-}
 
-// GetLinksWithResponse request returning *GetLinksResponse
-func (c *ClientWithResponses) GetLinksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLinksResponse, error) {
-	rsp, err := c.GetLinks(ctx, reqEditors...)
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
 	}
-	return ParseGetLinksResponse(rsp)
-}
 
-// PostLinksWithBodyWithResponse request with arbitrary body returning *PostLinksResponse
-func (c *ClientWithResponses) PostLinksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksResponse, error) {
-	rsp, err := c.PostLinksWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "tag_match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_match", r.URL.Query(), &params.TagMatch)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_match", Err: err})
+		return
 	}
-	return ParsePostLinksResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostLinksWithResponse(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksResponse, error) {
-	rsp, err := c.PostLinks(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
-	return ParsePostLinksResponse(rsp)
-}
 
-// GetLinksUserUserIDWithResponse request returning *GetLinksUserUserIDResponse
-func (c *ClientWithResponses) GetLinksUserUserIDWithResponse(ctx context.Context, userID string, reqEditors ...RequestEditorFn) (*GetLinksUserUserIDResponse, error) {
-	rsp, err := c.GetLinksUserUserID(ctx, userID, reqEditors...)
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
 	}
-	return ParseGetLinksUserUserIDResponse(rsp)
-}
 
-// DeleteLinksIdWithResponse request returning *DeleteLinksIdResponse
-func (c *ClientWithResponses) DeleteLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteLinksIdResponse, error) {
-	rsp, err := c.DeleteLinksId(ctx, id, reqEditors...)
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
 	}
-	return ParseDeleteLinksIdResponse(rsp)
-}
 
-// GetLinksIdWithResponse request returning *GetLinksIdResponse
-func (c *ClientWithResponses) GetLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetLinksIdResponse, error) {
-	rsp, err := c.GetLinksId(ctx, id, reqEditors...)
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
 	}
-	return ParseGetLinksIdResponse(rsp)
-}
 
-// PutLinksIdWithBodyWithResponse request with arbitrary body returning *PutLinksIdResponse
-func (c *ClientWithResponses) PutLinksIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error) {
-	rsp, err := c.PutLinksIdWithBody(ctx, id, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
-	return ParsePutLinksIdResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutLinksIdWithResponse(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error) {
-	rsp, err := c.PutLinksId(ctx, id, body, reqEditors...)
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
 	}
-	return ParsePutLinksIdResponse(rsp)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetUsersWithResponse request returning *GetUsersResponse
-func (c *ClientWithResponses) GetUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersResponse, error) {
-	rsp, err := c.GetUsers(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// PostLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostLinksParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLinks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseGetUsersResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostUsersWithBodyWithResponse request with arbitrary body returning *PostUsersResponse
-func (c *ClientWithResponses) PostUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersResponse, error) {
-	rsp, err := c.PostUsersWithBody(ctx, contentType, body, reqEditors...)
+// GetLinksBroken operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksBroken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksBrokenParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
-	return ParsePostUsersResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostUsersWithResponse(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersResponse, error) {
-	rsp, err := c.PostUsers(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
-	return ParsePostUsersResponse(rsp)
-}
 
-// DeleteUsersIdWithResponse request returning *DeleteUsersIdResponse
-func (c *ClientWithResponses) DeleteUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteUsersIdResponse, error) {
-	rsp, err := c.DeleteUsersId(ctx, id, reqEditors...)
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
 	}
-	return ParseDeleteUsersIdResponse(rsp)
-}
 
-// GetUsersIdWithResponse request returning *GetUsersIdResponse
-func (c *ClientWithResponses) GetUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdResponse, error) {
-	rsp, err := c.GetUsersId(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksBroken(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseGetUsersIdResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutUsersIdWithBodyWithResponse request with arbitrary body returning *PutUsersIdResponse
-func (c *ClientWithResponses) PutUsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error) {
-	rsp, err := c.PutUsersIdWithBody(ctx, id, contentType, body, reqEditors...)
+// GetLinksTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksTrash(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksTrashParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
-	return ParsePutUsersIdResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutUsersIdWithResponse(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error) {
-	rsp, err := c.PutUsersId(ctx, id, body, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
-	return ParsePutUsersIdResponse(rsp)
-}
 
-// ParseGetLinksResponse parses an HTTP response from a GetLinksWithResponse call
-func ParseGetLinksResponse(rsp *http.Response) (*GetLinksResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
 	}
 
-	response := &GetLinksResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksTrash(w, r, params)
+	}))
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Link
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// GetLinksUserUserID operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksUserUserID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "userID" -------------
+	var userID string
 
-// ParsePostLinksResponse parses an HTTP response from a PostLinksWithResponse call
-func ParsePostLinksResponse(rsp *http.Response) (*PostLinksResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userID", runtime.ParamLocationPath, chi.URLParam(r, "userID"), &userID)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
 	}
 
-	response := &PostLinksResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksUserUserIDParams
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// ParseGetLinksUserUserIDResponse parses an HTTP response from a GetLinksUserUserIDWithResponse call
-func ParseGetLinksUserUserIDResponse(rsp *http.Response) (*GetLinksUserUserIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
 	}
 
-	response := &GetLinksUserUserIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksUserUserID(w, r, userID, params)
+	}))
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Link
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// DeleteLinksId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLinksId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// ParseDeleteLinksIdResponse parses an HTTP response from a DeleteLinksIdWithResponse call
-func ParseDeleteLinksIdResponse(rsp *http.Response) (*DeleteLinksIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &DeleteLinksIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLinksId(w, r, id)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseGetLinksIdResponse parses an HTTP response from a GetLinksIdWithResponse call
-func ParseGetLinksIdResponse(rsp *http.Response) (*GetLinksIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetLinksId operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	response := &GetLinksIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Link
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksId(w, r, id)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParsePutLinksIdResponse parses an HTTP response from a PutLinksIdWithResponse call
-func ParsePutLinksIdResponse(rsp *http.Response) (*PutLinksIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PatchLinksId operation middleware
+func (siw *ServerInterfaceWrapper) PatchLinksId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &PutLinksIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchLinksIdParams
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	headers := r.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
 		}
-		response.JSON500 = &dest
 
-	}
-
-	return response, nil
-}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
-func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		params.IfMatch = &IfMatch
 
-	response := &GetUsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "Prefer" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Prefer")]; found {
+		var Prefer Prefer
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Prefer", Count: n})
+			return
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, valueList[0], &Prefer)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Prefer", Err: err})
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+		params.Prefer = &Prefer
 
 	}
 
-	return response, nil
-}
-
-// ParsePostUsersResponse parses an HTTP response from a PostUsersWithResponse call
-func ParsePostUsersResponse(rsp *http.Response) (*PostUsersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchLinksId(w, r, id, params)
+	}))
 
-	response := &PostUsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PutLinksId operation middleware
+func (siw *ServerInterfaceWrapper) PutLinksId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// ParseDeleteUsersIdResponse parses an HTTP response from a DeleteUsersIdWithResponse call
-func ParseDeleteUsersIdResponse(rsp *http.Response) (*DeleteUsersIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &DeleteUsersIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutLinksIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
 		}
-		response.JSON500 = &dest
+
+		params.IfMatch = &IfMatch
 
 	}
 
-	return response, nil
-}
+	// ------------- Optional header parameter "Prefer" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Prefer")]; found {
+		var Prefer Prefer
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Prefer", Count: n})
+			return
+		}
 
-// ParseGetUsersIdResponse parses an HTTP response from a GetUsersIdWithResponse call
-func ParseGetUsersIdResponse(rsp *http.Response) (*GetUsersIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, valueList[0], &Prefer)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Prefer", Err: err})
+			return
+		}
+
+		params.Prefer = &Prefer
 
-	response := &GetUsersIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+		params.IdempotencyKey = &IdempotencyKey
 
 	}
 
-	return response, nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutLinksId(w, r, id, params)
+	}))
 
-// ParsePutUsersIdResponse parses an HTTP response from a PutUsersIdWithResponse call
-func ParsePutUsersIdResponse(rsp *http.Response) (*PutUsersIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	response := &PutUsersIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// PostLinksIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostLinksIdRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получить все объекты Link
-	// (GET /links)
-	GetLinks(w http.ResponseWriter, r *http.Request)
-	// Создать новый объект Link
-	// (POST /links)
-	PostLinks(w http.ResponseWriter, r *http.Request)
-	// Получить ссылки, связанные с пользователем
-	// (GET /links/user/{userID})
-	GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string)
-	// Удалить объект Link по ID
-	// (DELETE /links/{id})
-	DeleteLinksId(w http.ResponseWriter, r *http.Request, id string)
-	// Получить объект Link по ID
-	// (GET /links/{id})
-	GetLinksId(w http.ResponseWriter, r *http.Request, id string)
-	// Обновить объект Link по ID
-	// (PUT /links/{id})
-	PutLinksId(w http.ResponseWriter, r *http.Request, id string)
-	// Получить всех пользователей
-	// (GET /users)
-	GetUsers(w http.ResponseWriter, r *http.Request)
-	// Создать нового пользователя
-	// (POST /users)
-	PostUsers(w http.ResponseWriter, r *http.Request)
-	// Удалить пользователя по ID
-	// (DELETE /users/{id})
-	DeleteUsersId(w http.ResponseWriter, r *http.Request, id string)
-	// Получить пользователя по ID
-	// (GET /users/{id})
-	GetUsersId(w http.ResponseWriter, r *http.Request, id string)
-	// Обновить пользователя по ID
-	// (PUT /users/{id})
-	PutUsersId(w http.ResponseWriter, r *http.Request, id string)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLinksIdRestore(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// GetLinksIdStats operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksIdStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type Unimplemented struct{}
+	var err error
 
-// Получить все объекты Link
-// (GET /links)
-func (_ Unimplemented) GetLinks(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// Создать новый объект Link
-// (POST /links)
-func (_ Unimplemented) PostLinks(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-// Получить ссылки, связанные с пользователем
-// (GET /links/user/{userID})
-func (_ Unimplemented) GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// Удалить объект Link по ID
-// (DELETE /links/{id})
-func (_ Unimplemented) DeleteLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdStatsParams
 
-// Получить объект Link по ID
-// (GET /links/{id})
-func (_ Unimplemented) GetLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "from" -------------
 
-// Обновить объект Link по ID
-// (PUT /links/{id})
-func (_ Unimplemented) PutLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-// Получить всех пользователей
-// (GET /users)
-func (_ Unimplemented) GetUsers(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "to" -------------
 
-// Создать нового пользователя
-// (POST /users)
-func (_ Unimplemented) PostUsers(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-// Удалить пользователя по ID
-// (DELETE /users/{id})
-func (_ Unimplemented) DeleteUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksIdStats(w, r, id, params)
+	}))
 
-// Получить пользователя по ID
-// (GET /users/{id})
-func (_ Unimplemented) GetUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Обновить пользователя по ID
-// (PUT /users/{id})
-func (_ Unimplemented) PutUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// GetRCode operation middleware
+func (siw *ServerInterfaceWrapper) GetRCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	var err error
 
-// GetLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinks(w, r)
+		siw.Handler.GetRCode(w, r, code)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1792,12 +5614,35 @@ func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostLinks(w http.ResponseWriter, r *http.Request) {
+// GetUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostLinks(w, r)
+		siw.Handler.GetUsers(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1807,23 +5652,38 @@ func (siw *ServerInterfaceWrapper) PostLinks(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLinksUserUserID operation middleware
-func (siw *ServerInterfaceWrapper) GetLinksUserUserID(w http.ResponseWriter, r *http.Request) {
+// PostUsers operation middleware
+func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "userID" -------------
-	var userID string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userID", runtime.ParamLocationPath, chi.URLParam(r, "userID"), &userID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
-		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinksUserUserID(w, r, userID)
+		siw.Handler.PostUsers(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1833,23 +5693,35 @@ func (siw *ServerInterfaceWrapper) GetLinksUserUserID(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteLinksId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLinksId(w http.ResponseWriter, r *http.Request) {
+// GetUsersTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersTrash(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersTrashParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLinksId(w, r, id)
+		siw.Handler.GetUsersTrash(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1859,8 +5731,8 @@ func (siw *ServerInterfaceWrapper) DeleteLinksId(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLinksId operation middleware
-func (siw *ServerInterfaceWrapper) GetLinksId(w http.ResponseWriter, r *http.Request) {
+// DeleteUsersId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1874,8 +5746,10 @@ func (siw *ServerInterfaceWrapper) GetLinksId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinksId(w, r, id)
+		siw.Handler.DeleteUsersId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1885,8 +5759,8 @@ func (siw *ServerInterfaceWrapper) GetLinksId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutLinksId operation middleware
-func (siw *ServerInterfaceWrapper) PutLinksId(w http.ResponseWriter, r *http.Request) {
+// GetUsersId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1900,8 +5774,10 @@ func (siw *ServerInterfaceWrapper) PutLinksId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutLinksId(w, r, id)
+		siw.Handler.GetUsersId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1911,27 +5787,68 @@ func (siw *ServerInterfaceWrapper) PutLinksId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetUsers operation middleware
-func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
+// PatchUsersId operation middleware
+func (siw *ServerInterfaceWrapper) PatchUsersId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsers(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// PostUsers operation middleware
-func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchUsersIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	// ------------- Optional header parameter "Prefer" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Prefer")]; found {
+		var Prefer Prefer
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Prefer", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, valueList[0], &Prefer)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Prefer", Err: err})
+			return
+		}
+
+		params.Prefer = &Prefer
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsers(w, r)
+		siw.Handler.PatchUsersId(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1941,8 +5858,8 @@ func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteUsersId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUsersId(w http.ResponseWriter, r *http.Request) {
+// PutUsersId operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1956,8 +5873,72 @@ func (siw *ServerInterfaceWrapper) DeleteUsersId(w http.ResponseWriter, r *http.
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutUsersIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	// ------------- Optional header parameter "Prefer" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Prefer")]; found {
+		var Prefer Prefer
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Prefer", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, valueList[0], &Prefer)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Prefer", Err: err})
+			return
+		}
+
+		params.Prefer = &Prefer
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteUsersId(w, r, id)
+		siw.Handler.PutUsersId(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1967,8 +5948,8 @@ func (siw *ServerInterfaceWrapper) DeleteUsersId(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetUsersId operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Request) {
+// GetUsersIdProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdProfile(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1982,8 +5963,29 @@ func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdProfileParams
+
+	// ------------- Optional query parameter "recent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "recent", r.URL.Query(), &params.Recent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recent", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "top_tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "top_tags", r.URL.Query(), &params.TopTags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "top_tags", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersId(w, r, id)
+		siw.Handler.GetUsersIdProfile(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1993,8 +5995,8 @@ func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutUsersId operation middleware
-func (siw *ServerInterfaceWrapper) PutUsersId(w http.ResponseWriter, r *http.Request) {
+// PostUsersIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersIdRestore(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2008,8 +6010,10 @@ func (siw *ServerInterfaceWrapper) PutUsersId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutUsersId(w, r, id)
+		siw.Handler.PostUsersIdRestore(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2132,12 +6136,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login", wrapper.PostAuthLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.PostAuthRefresh)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/links", wrapper.GetLinks)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/links", wrapper.PostLinks)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/broken", wrapper.GetLinksBroken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/trash", wrapper.GetLinksTrash)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/links/user/{userID}", wrapper.GetLinksUserUserID)
 	})
@@ -2147,24 +6163,48 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/links/{id}", wrapper.GetLinksId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/links/{id}", wrapper.PatchLinksId)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/links/{id}", wrapper.PutLinksId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/links/{id}/restore", wrapper.PostLinksIdRestore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{id}/stats", wrapper.GetLinksIdStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/r/{code}", wrapper.GetRCode)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users", wrapper.GetUsers)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users", wrapper.PostUsers)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/trash", wrapper.GetUsersTrash)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/users/{id}", wrapper.DeleteUsersId)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{id}", wrapper.GetUsersId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/users/{id}", wrapper.PatchUsersId)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/{id}", wrapper.PutUsersId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/profile", wrapper.GetUsersIdProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{id}/restore", wrapper.PostUsersIdRestore)
+	})
 
 	return r
 }
@@ -2172,24 +6212,107 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+yY3W7bNhTHX0XgdinU7pbd+G5btsFAL4oNuSqKQrUYl61FqiTVITAE1A2GFUuBPsE6",
-	"FHsBN6uRr9l5hcM3Kg7pD9mWP5Q6toPmxpZofpxz+OOf57hJaiKKBadcK1JpElV7QqPAPv4kpZD4EEsR",
-	"U6kZtc01EVL8pjyJSOUB4UL/LBIeEp/UBN9vsJomPnkchL/S5wlV+MK4ppIHjd+ofEGlm/ehT/RBTEmF",
-	"KC0Zr5PUJxFVKqjb2Sd+S30i6fOESRrimtaG0Qzi8VNa0zjDPcaf5ZgsaaBp+CjQOVP7hIX5zVFQd+OZ",
-	"ppHK7dNvCKQMDux7UC86gukGze2ZxOE8qxPZyG9XVD7KdWkiiAy3zC3vZhuN7TsyjIGfDeGYZbM24Ufb",
-	"f3ortjXW1xDMyRAO5soL2Z6iclXcxoFSvwsZXgkqhQc1oks6POyeWbUYK+h4QVbm+/f5DkybiUMZ3xd2",
-	"UseQhdwLeOihB97396vEJy+oVExwUiF375TvlNEeEVMexIxUyLe2CdfRT6x7pQbjz+xTndrdQN8DzQSv",
-	"hqRCfqH6nu2AZqtYcOWi8k257GSYa8rtuCCOG6xmR5aeKlx/oONjx+NrSfdJhXxVGil+qS/3JaubUycG",
-	"/Q6pqkkWa+cXvIdLODUt6MG5Bz34YP6CDpybV9CDY5xgp6B184xyF0WeFX9DB46hY15C1xzBmQcn0IZL",
-	"8xJ6poVWfLcWK96Z13AKH+Ac2p5pWXOcUW0Lm0qiKJAH2PMf6MGFOTR/wql5Zd54cIz9xwJojrzBJsRC",
-	"5fBwX6gMEPZq/UGEByvzM6Pa6fhZ0TKh6RSFd/FrMiIjfzxzaFpwCR3zGrrQ8ywzJ/AR2tC9BWUWKO8H",
-	"QXKYdPFYOcMzqPRBSf2+hJRQxUpN/KzupgsFBQVrz/a1aiSDiGoqFak8aBKG9qJCEZ84GSXJoOs4EH4m",
-	"KpNC+3BrJcu0TMscwQW+bA+FO+WdNVjhROgNnCBUSBh08B0p6+BHG87gI3TweM5Xr2EQz+HUR6CPzVvr",
-	"Utf61/FMy4PLvNWgA/9nwW2yMHUy0qAuAxhHdte2W2qr4VK02lv9c0jdKShrh1bSLlzchlu5eV3xr33x",
-	"TFAWIvRvP0r9629SzCwuXnUXzZ6rXeuioLzSa3WZ+GVCty5BWLCDNyCNmsdRnOQlUcm1c7T5zKyghGEU",
-	"XaaRlbEv5168ccfg3XC/Fh4DvGoxgZtbZu7ZDuvI2ezfHIXLzFmpxNltHVGs4DR/LAjm7MpzxMjq9S3z",
-	"H9CVK89Zye1tFbqiKhR68B9eFrn8mLcZqVkyq7dEbTKrXxaZ/Ax/e4q17eNnItufgcwySf9aISmvVNGK",
-	"busGCoAbDdnUFbcEZrNqguvGbPN3ZvnqAvil1wc3+pRM1QoLT0mapp8CAAD//6jRHsMVIAAA",
+	"H4sIAAAAAAAC/+w9aW8cR3Z/pdBZwDmahyhpFyaQD17JXnNj7Qo6nDiKQrRmimSv5qC6exRrBQIkxzLl",
+	"UBYBI4EXRixF9gL52hpypOYxw79Q9Y+C915Vn9UzQ1qkKIpfCM5MH+++q+qhVWnWF5sN3gh8a/qhteh4",
+	"Tp0H3MNPl1qe3/Tgvyr3K567GLjNhjVtie9FT4RyTXRFT0Siyxr8y2C2glczEYnXTOzLZdEVW3JdbMm2",
+	"/EZ0xTaTK3JVLosQbpJfy3XLtlx43L0W9x5YttVw6tyatug5lm35lQVed+D1wYNF+MUPPLcxby0t2dZM",
+	"ldcXmwFvVB78E39gAPG56IuOXBV9uczEaxEiRH25IkImV5hcFV2xx8Qr0WViR+zKp3JN9OGbiH7bpU/7",
+	"oi92ZVuuiVB05SqTK6IvHykkAPueXBfbTPTlqujAFTYTIYPfmOjIdbpf9OQG3p2Ggz70AErRH2cI7w7c",
+	"uy+6chlv3s7dUHigXJEbNgEZo5qH+MLkh3b2OUPQF1tyWbbFpojEXooWNpuZG7viBJUF4PCuiNhVj89x",
+	"z/DGqalxJn6gpzKxBbwH1ouObOMFohPLBz49lI/0U56I10iRkF4sN0icADmxgwQPbSZeim7uSyb6ogfX",
+	"bgJJ5bKIEAlFo3EmnmkGsZh9Ef0It27GX5FMA1uQgwDkniayXBG78DVwflP0Y8oxsSf64hXcxUQkV4q4",
+	"yCcgOcTsUIv9Aneq3EvkPiXSYyDTaQWoO19+xhvzwYI1PXXxom3V3Yb+fM42qcccsqqoFx/fcOaJpr/7",
+	"+MY4E/+NSEVAhJfyP0VX7KCUI+MVW0CqIvFa7Cl131VihzIF+MonpAA7KCkp2YQHXTg3ZbO/R8Hal22U",
+	"A6Tt65wJQfpEYk+E8qlcJQHpI8uWxY5slxJNCeUQc/GZW3eDIjXE/4qQMJPLBfNETGeyjezdlWvqp6fs",
+	"4mSJ6arha9KgzDW9uhNY05bbCM5PWTZw0q236tb0xclJ5CN9SrjoNgI+zz0Em1SsCLfHg5bX+Ee826kx",
+	"MCHiNYprKL9Rajg1eSGlKahnNohsKHZAKfvE2w3RAaFGxDZSdozMZFoqwAiQnTKRREHk8UWP+7wROAhp",
+	"CdMUWoNYtmRbHvcXmw2foyv6qFLhvn+ZN1xehc+VZiPgDWSps7hYcyv4wok/+UCfh6kH/8rjc9a09TcT",
+	"iaOboF/9iY89r+nRy3Jy8SMapz6Ka4imdY3Uex8lpAMU3CXbQfY6lF+LSERWwTVdcf26VsWjBTpnP4BL",
+	"aN8NFkn0kLtpS5/xkX2xB5jcbDitYIE3AoD0WOj+DA1HW65mHQY5HNFTLkO5ExEpwe6KHnNQQFJOARD4",
+	"nHu+22wcHw/Es5QZLVrNjBtJ/KZWv8Q4x2YNXqHeCkBddtzag89d31Xhmtdc5F7gko5UnYAXbcUXX3zx",
+	"xdiVK2OXL1sFT2FbrYZ7r8Vn78Mjm56fN1m/vmAVDZNt3Y8hGHoxKvK9luuB/NwiGOMHFN9/O35C886f",
+	"eCWAtxG1C+hWmlVElzfAgN6yGs3gk2arUbVsYPJcza2AKb7jVK/xey3uB/g2EOim5/6Zw2VzTe+OW61y",
+	"MFSLHq80G1UXqPaJ49bwAsDCazi169y7zz2C47aBilUeOG7NLxJf/I8I5WMRYVSyhgIbYkixriVgC/3c",
+	"S7wApBpcMd7xUuyIiKRBrlBMiKoc2mSWwQ69RIMdio58rGOSlBoDAgGv+yNJ82VEwVqKsXM8z3kAn+vc",
+	"9515brDSxFruB7Nu1YD7X1BZe4CU/EpE4HuMAbmNWgsK80p0bSbX4CNGiXDZJka8YLZ2RJf9y5ji5thM",
+	"1STP991mDTXaL8kJ0GrnXk/xOn3xGNOWxyKKv0qCEBUQrKDN/zqFFdr+2Ex1sgzrjsqHT1xeq36uESiy",
+	"IqdKKP+l+qL4WTQSzbrjNgzEeZGAbDOFc0+2UbYiynGUWMr2OBM/KY+yI/raGXJ476zbmGuaOFPngVN1",
+	"ArStTpUUzaldzQBXuKmAm8cdv2kC/zkG/WsiUulAWoc6Ch/5CF3IHsO4flNEKuTrogiQSEQqGvz842vX",
+	"Z/74h9krM9evfHTj0qeHQdnjfrPlVfgsxT0HVhAUn8j85vjZQ19OvxRe/pOIxH7mRQYq1NzG3UO+X79W",
+	"m+cMqbJ33zalMGlRx19Nop5TmaK0p3E2yNcc3G/kzB556xDt7B5mNMsijHV8H4OUJ0zskBnflU/jMJuB",
+	"S5fLOvQGw78j2wbqunVnnvvjk9Yw/AnMbLBhIsdnbuOuwVF6HMK3WScw0qDKazz5OUeI73QKDOHklgiT",
+	"ZIGsdiwWsg0J1IpcR2O9o8pAO5iMvwatxHqP4eUZBhWiKRLNME4SCykayW+uKMLQJ2LKgiqFeb1cUdZA",
+	"rii4uul4DMPe12JLPX3DBO0Cd2rBwjA7Dlz4lK6Ee9z5hZo7vxD4I92XXL1kW+RWC2CQ3MBPsV8ps5yx",
+	"F/crTY8bM2CKoDErkKs6DpFPcvwlwSVhj5CCXcwh8ioCVaR7lp0EhtVm606NJ9RstOp3KIr0F5peMKvD",
+	"uBxcP0BspI3N7z6+wSa8iYdw7ZKNaaohTciJoJ1laU+uy0flmS9JBJUxIrhwBwAA7280bs78AekfuEHN",
+	"HEO1FquD1LPl1czf+9ybLRGQ+5T3mAseSLOurrFEVBN4JbZUATDOWZA06BWMkRglK/vK0m2JMFG+Dj2z",
+	"I9fB9EF9z7IPnCm4VUtTjYiQoKzoHyuCnTZxGYImpCgzl5fwTgOloAQAWMfSI7oMwbFZ6koobBEUg2xJ",
+	"zkzJp4pScF3BqOGDGGF8MG9misL/iNjOXE5UY0dE6cJfj6pxClE7E7vCh00ShnRNlVEpE0rU40x8L8Is",
+	"+5VYgZfD8qFcTcJ68VKuZ6vokSqPUptAK7R8Kr+hGDwqC5LMDuVQtnGQIXpuLEw/IW8itnOWQ30htmx2",
+	"fuz8FKAKdOgg60PlFXfJKSVejJIJuWyzD8Y+AJH6YPaD8lqbiSVAeZAXJ4CM1Zq2/v3WR2P/6oz9eXLs",
+	"w9mx2w/P2+enln515KbswLYqp/Qk9Uq79V1lqvtp7I0NPu21bCObsH6X0k2xhaZtO5fcYeNDub622E+l",
+	"4y2vVuZxVO9lR4Qp7ZJtpVOp58sN0LWCPlcWeOVuueXnuu5R+GXOcWstj8/6gcedu2bjRfGaXNOOL41u",
+	"X4etWwjblmUXC9XFqo/Hq67HK8GsYnM+Zkb93tSWAQiXsYnkJiK5HNeTOzYTXW2HsIEDWrSL4VqYkuoC",
+	"/n7gBC2/RGMnM09NFbSJLS8pPhgF5Zxwpt9qp5lXYEipxGZiwUICDuzZR2sahyXKZyAeQDbqn3SI0Olo",
+	"LERLnOqpgHv5VuzEVnZZl37lOrnnHfbpjSufqSiJXtxRhQ+kHl6OtSZdC5CrcG+H1R3vrmpZYjlFdRlW",
+	"8giIbYPigPMraMLRG6GlEpZ85vpBMWWCvDcL0LDA3QRjqi1uinBlWy6jK1xm2jYpx2fqlpeGvVTwKBq4",
+	"7AOwDDXY+BLSZbJ7VVfQzcWbOafm8yEBS6NVqzmQDEwHXosf0nmXPCQRDGf+lz5Bi9ZQeA/l74zUvR44",
+	"5pK+WyuZblBdP+Awtal0dLBFHq/Dbt64ZDMUh0jrqI7X5SOd3WjHgM1ZDK6exnZ3JOFPtyQMOgBCVZam",
+	"BM3Aqc0eoJlg7FfkSPMz4AuIYNTWiyvtGNeuxj2jSBVzH4mufCzbzF08RI6ikcuhUgTTVpwsUy7/eqte",
+	"d7wHpi5Hq2EuzKyIbirsUAiZZiig7UuZOZACTMQr7BNjmtvJFGlEdxQqABEq3AjW84wZivK5ELEjDTWZ",
+	"tTijwMybqb7rslwXe6PKYZkRDpqLs9omGLRItjEi6MT6g22aFarjZaoJKq+hBjCmJNSFJPGifAfdHhUC",
+	"N0U4Kug3nPlLyObhJX+4KiZ/CjmjYDXn3YZufhUEa9Hx/f9oetVSA6aL1kMidn2lnTzRBMxVrznn1nhJ",
+	"Ly8OdUfoutoW1Y6H9jB2RF9nimJbRX9JPBiJXcuOq9OABqhp3geWYK0g0DG6CeFrfM7j/kIp/T36fTZo",
+	"3uWN4XTOXm58YbOWKbg71brbsKDxggU3eJxTHWs2MmYoYXkshOUmaATDEDjzw3GBi2z1WBMmNzRJsnBQ",
+	"i7+UYLbFv1x0Pe7Puo2BlWzxCqtcoKmFqQER6v4dZHKiJ7ZGLV/ZQzkK6nqXN+KGzGAiZbDNPzzzqAzi",
+	"Jnre9Ln39toCZrdEOfhoTYKS8MFTAj/IZqBSjFBkLbd3p6iamrLVSLvD1k1BnpK6aVaqTDXImzdnLpdG",
+	"J7+0FgkPv/+bt1qHtNXYcCL2aHunmYh0Rs7UsC/NOKqpYRiYjOihNEO6ieUJNRosNwiiNRzk6ott5hpH",
+	"HgY68gPpyBt3+iAo5gT7pKfGtooIRi0BAKZDwzd6ZBmhDpNgHwnvhw0Xm6Gn+K4ktDNF3/+njGekR2++",
+	"EpFOV1KRGw1cJ36mTwPHyfQcKi6Uo+Lx4m2Gfd8VLL53GOYfpIehMtWqE7MutrMvfzJqyJ4JZ0vS3pFS",
+	"ljjzU0wYTdBygqVoXJQsCJZ5peW5wYPr8ABiyR3ueNz7qBUsJJ8+0Q7l9/98Q0/mwpPo10RBFoJgkSYf",
+	"cXRj+qEul2Aey5xGlQGI7KOrMykvMm2dG58cnwQkm4u84Sy61rR1Hr/CpsUCwjUB03kTNUhb4ONikywH",
+	"SBPOd8xUYXi46QcAOmY3VjyC9ttm9cEbm+/MZE5LWWpDKSg/nTw1OfnG3k3Br2m2tKQTxUQIhi/tnLCf",
+	"FBd8geoX3iCEg8em9eRYfv0KQXGu7OExPSfyU8dLtnXxWKB/Fs+MhUnkAX/DjCJZ07du25avCzaW+A5M",
+	"jjJkos8wqtvEaLZN6x5wRIJmhERUMF3qApheSNKQvujgS0klVPA/XClU0nlEapFLaU+MYvykySbXqV+B",
+	"9KOcRK6fif/Riv8z8ZJyHBWwKmFNSbOKw5ArEMYNlvjYdc5zg6T/jgfoNi07s1jxlrlvX+ycKZ+v+2b4",
+	"r57rwI5lxKCgNs7EXwF18RpkCjtgsl2SkUFSt0f6LldovYVeUxevGqRMr1+ybuje4OVLBdz+SsEKBLGE",
+	"gK44wjq9/EhWZnlaaq0gsasEIirRJDCN2pgrAus0HrAxZl5N08eInHqZGgWbObUa3NHDvAd1ukPFbroi",
+	"Kgd5tl5YC1blc06rFhAgqYIffXJqNWOx76HxDcn80UGY9aOIEI8NEJ3NVNoRsiT9xpFrLTWRdu1AnBJs",
+	"41vngtxSqmTyzgn4WODWuWWPAOZ3ekJ6KKC0uvVQ0N7hc02Pvwlwn5MIg1xhw0Al+Drs0f2QFOTJ7C5I",
+	"27J57hFno16UjDWWLgj0eI3fdxoVXoJ+06vmmBSLoV9Rc73os/VjzDJpsuuJEZygRY4jXKjWdS/dPkJv",
+	"HffWTa7mRSb9DvPrDDtnLvvNuOzEST/Px5wpist1hrkbFUpSrCErDaW3rxKHg0oRJYGs0cXupVysXk1Y",
+	"HrmWOPQhUpzbAoCk+QhywWRIdKSA99wbffPQRYbYtoe68WPFrKTbatlq5S1CBhVmUyUfzf6KWv6bPFiE",
+	"evh61CXWzYpTMsb/PFkske0GY60m1Q3uDnzH0jtvFS5Mnh9+X2apM9704TGg/HP5Fgx6IXGXFgakGRZS",
+	"aC+/hbHJfF22HXvc/PYT32L/dY/llyyLLlR4S3a6QFpMTQ0nYMkC7BNolV9oZSD16CUJSkoTmbIDOjWa",
+	"uOPpBuPADOm3nmoV5szqL4hsT28A8hL9IoyfpIc+zuKQI49DFOGLU0GGHgB+s4Pf7OqNR3pqYVdupVLp",
+	"pHNKjwLP8RdSapRF6gqOTUCcE89NQNYTiS1InHNd7hVI7uVTglr3sqn6BwnKrk5kUw1eZUrhRhiWjhXQ",
+	"rMw3ENYzXR5BlzMTCb1TqNOHiiJOuiHIc60w3FgcFSlJWNIqDrow8RD+zlxeGuoxoXF1E68t0TToVGUV",
+	"DS/NpgTvrd6dMiW7cAzQl7b0euTzQrFNYydD1SfjPMGtyA21w5xWpvJBrL20yjx0q0vkDWH+yzRkmY7+",
+	"tUPD8pmIMhXylCe0te9GLWeiC+ilytLgPVfiMfY+7e0jn6jlutAi6IueXNMwU62Rlpes0TYOgHjBe15G",
+	"HFC3cbuQ4TrtVg+kz0Xlu2Bcxl6er6fM3lvILC8c95ZIRbk+ea7pZ8WSYqUsqZOxmcsA+kB3clwiN3m8",
+	"FacM+46xxLR04q35Oyfpg6rCBVlfNO8oKX6iLUJxbvorNPq06UaX/f76H//ArnBvnjMcbmN/e+2TS+w3",
+	"5z/89d9Ns7hnHC+Gz243kWRKsQ9TDmzDZrAyC2aLiwvy7Xg5PjVytYv4Jjv71R3X3V6VLqVdSaTLMeRq",
+	"XuNiqFzZGvA5Qi0fHv/pLT5HuFTttzh6hbwOPBtDhv/DwS3IVYLreKdDDlMsz8+KjGTNflTB04beVOqN",
+	"GLYDBw552O14r97UGHWypRncktqWiCSCZfcRfYvov/1cIbOzUzxGuo91mrX3NTI7az2kWg/nRmg95Dcc",
+	"PYE+Px67lmt5QzJKENAy9XBbwbvmCu13tb185jHPPOZ72wg/c4qnxCmesj7+s9FdaLbQCtgGamPGIfNR",
+	"M9Vr6trTUlTKFJKLFWBqBYWn1My8SPe2evG5KNm9OM6sTcbanDS1/66ka5HdDM6wxj1vBXy9+8+QejLt",
+	"EnQ0MXbB89N6yd2kGBfhvH544FH1Oa9ZfxMj3z/g+umu/LoI0CFG0oPmwWE6ajNJ/C1ptqqdjDF3yzTf",
+	"ks2crLPA8Ky6P6hHnBMi2p2juCOY6GuLRdua5sd0x5n4r8xmmejAd9XOfzAo/nV6M2aa36BNEcj26b2c",
+	"B9m8a5doo8Xhxk7tyHj4cOc8SatpLyucQdtIzgRLGt6hPgxIb36iRAIbC2ncs1liek56cIp3fnKqfOsV",
+	"0X0rMB2Pqv1g3FL3JGtd2eLI54o16fXBgzSL1CPee6JMN27iBQddMXGiZovivUFGms8t3UPobET3yJvC",
+	"A/ZvKhnAG7TY53Cie0zV2NTORse82Eft6zH6iNi7vvBnr3RrMPn0nal+ntjlPKVSg0d7huhX95hb1Um6",
+	"3v9Hp/v5fbAy5/CdLecZxfWbFvek9+02FFtS3n/I6oRc/N03bD9mWkuAxrdkLcGpDR4MCwJOczzxXiwP",
+	"GMjDAy8WII0bOvlcZlRHnYKGrQ5R//PbNqfulhvqbrmqh6Wx/BrCg0UPtyvskwu1S8+ySh97UDIUjZbg",
+	"bQ5FjxrXnP4B6QMsAjjxw9Klx7gPn5k+VomcfHuB+9n89KlUhNHS5qwqnJyR6nEaqaazIuKzzZPdmfR1",
+	"ihdb6RMjUif1GIakj1Ct39Uh6WS312Me+fqlRYYTP/51SDzORsHOhqeP1Fm9P8PDo3i9khnid81T2O9q",
+	"1frMoZw5lLPZ4hPvM46lUfD92Sjw0Y4CD3WI2QLkxGJykIK58l8qRFGurqj1KUTgO8nRfsn2prtortSg",
+	"XPokFsj9ItGjSmr6GBaaEOpkh1BQgDPn56INzB6toA4sxHtDvR6XwEkdIBpXLjMnsOChHWs6+LAZjgTg",
+	"MTBrdMYK0Xk/OQIGcmc6FmGcZdhXBkX61NjiUWFEk5cizBIjTPfP/DHfu8/UYEeI5N8lNuPuU21IpMV2",
+	"FvCuzeKxHTxATu3oPJqZ0G93q8SBlDn9t/L+z0xVH9ZxLJOkL3K7c2VPCMztzsRiHLBzbJduuXuxZKwz",
+	"PhrPMNqpz/mtO1+6ddh/9+KkbaG7hQ+TpkOUhmADIiD2aI8pJZ70gbaqJl0ZDaFzk6WDquqMvzeE01EX",
+	"Q7VwGR1g1iCUH1hJSpraIQbEO3Vsi3VWzDzSYuZIfAI27akZATroU29ot6VG28j6dfNNr1BvOZTyeyOt",
+	"hFH26x1dCXPwVkHJqpj3KjzeOAHrY/5CB0cOWyMTn9sm+idRq8vWq5Trt3HtSnboJXuc1K3bS7eX/n8A",
+	"207QEFacAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -22,12 +22,22 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LinkServiceClient interface {
-	CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*Empty, error)
+	// CreateLink и UpdateLink раньше возвращали Empty. Link совместим с ним по wire: старые клиенты пропустят поля
+	CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*Link, error)
 	GetLink(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*Link, error)
 	GetLinkByUserID(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*ListLinkResponse, error)
-	UpdateLink(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetLinksSummary(ctx context.Context, in *GetLinksSummaryRequest, opts ...grpc.CallOption) (*LinksSummary, error)
+	UpdateLink(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*Link, error)
 	DeleteLink(ctx context.Context, in *DeleteLinkRequest, opts ...grpc.CallOption) (*Empty, error)
-	ListLinks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListLinkResponse, error)
+	ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinkResponse, error)
+	SearchLinks(ctx context.Context, in *SearchLinksRequest, opts ...grpc.CallOption) (*SearchLinksResponse, error)
+	ResolveShortCode(ctx context.Context, in *ResolveShortCodeRequest, opts ...grpc.CallOption) (*Link, error)
+	GetLinkStats(ctx context.Context, in *GetLinkStatsRequest, opts ...grpc.CallOption) (*LinkStats, error)
+	ListBrokenLinks(ctx context.Context, in *ListBrokenLinksRequest, opts ...grpc.CallOption) (*ListLinkResponse, error)
+	RestoreLink(ctx context.Context, in *RestoreLinkRequest, opts ...grpc.CallOption) (*Link, error)
+	ListTrash(ctx context.Context, in *ListLinksTrashRequest, opts ...grpc.CallOption) (*ListLinkResponse, error)
+	DeleteLinksByUserID(ctx context.Context, in *DeleteLinksByUserIDRequest, opts ...grpc.CallOption) (*DeleteLinksByUserIDResponse, error)
+	RestoreLinksByUserID(ctx context.Context, in *RestoreLinksByUserIDRequest, opts ...grpc.CallOption) (*RestoreLinksByUserIDResponse, error)
 }
 
 type linkServiceClient struct {
@@ -38,8 +48,8 @@ func NewLinkServiceClient(cc grpc.ClientConnInterface) LinkServiceClient {
 	return &linkServiceClient{cc}
 }
 
-func (c *linkServiceClient) CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *linkServiceClient) CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
 	err := c.cc.Invoke(ctx, "/pb.LinkService/CreateLink", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -65,8 +75,17 @@ func (c *linkServiceClient) GetLinkByUserID(ctx context.Context, in *GetLinksByU
 	return out, nil
 }
 
-func (c *linkServiceClient) UpdateLink(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *linkServiceClient) GetLinksSummary(ctx context.Context, in *GetLinksSummaryRequest, opts ...grpc.CallOption) (*LinksSummary, error) {
+	out := new(LinksSummary)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/GetLinksSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) UpdateLink(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
 	err := c.cc.Invoke(ctx, "/pb.LinkService/UpdateLink", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -83,7 +102,7 @@ func (c *linkServiceClient) DeleteLink(ctx context.Context, in *DeleteLinkReques
 	return out, nil
 }
 
-func (c *linkServiceClient) ListLinks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListLinkResponse, error) {
+func (c *linkServiceClient) ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinkResponse, error) {
 	out := new(ListLinkResponse)
 	err := c.cc.Invoke(ctx, "/pb.LinkService/ListLinks", in, out, opts...)
 	if err != nil {
@@ -92,16 +111,98 @@ func (c *linkServiceClient) ListLinks(ctx context.Context, in *Empty, opts ...gr
 	return out, nil
 }
 
+func (c *linkServiceClient) SearchLinks(ctx context.Context, in *SearchLinksRequest, opts ...grpc.CallOption) (*SearchLinksResponse, error) {
+	out := new(SearchLinksResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/SearchLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ResolveShortCode(ctx context.Context, in *ResolveShortCodeRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ResolveShortCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) GetLinkStats(ctx context.Context, in *GetLinkStatsRequest, opts ...grpc.CallOption) (*LinkStats, error) {
+	out := new(LinkStats)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/GetLinkStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ListBrokenLinks(ctx context.Context, in *ListBrokenLinksRequest, opts ...grpc.CallOption) (*ListLinkResponse, error) {
+	out := new(ListLinkResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ListBrokenLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) RestoreLink(ctx context.Context, in *RestoreLinkRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/RestoreLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ListTrash(ctx context.Context, in *ListLinksTrashRequest, opts ...grpc.CallOption) (*ListLinkResponse, error) {
+	out := new(ListLinkResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ListTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) DeleteLinksByUserID(ctx context.Context, in *DeleteLinksByUserIDRequest, opts ...grpc.CallOption) (*DeleteLinksByUserIDResponse, error) {
+	out := new(DeleteLinksByUserIDResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/DeleteLinksByUserID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) RestoreLinksByUserID(ctx context.Context, in *RestoreLinksByUserIDRequest, opts ...grpc.CallOption) (*RestoreLinksByUserIDResponse, error) {
+	out := new(RestoreLinksByUserIDResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/RestoreLinksByUserID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LinkServiceServer is the server API for LinkService service.
 // All implementations must embed UnimplementedLinkServiceServer
 // for forward compatibility
 type LinkServiceServer interface {
-	CreateLink(context.Context, *CreateLinkRequest) (*Empty, error)
+	// CreateLink и UpdateLink раньше возвращали Empty. Link совместим с ним по wire: старые клиенты пропустят поля
+	CreateLink(context.Context, *CreateLinkRequest) (*Link, error)
 	GetLink(context.Context, *GetLinkRequest) (*Link, error)
 	GetLinkByUserID(context.Context, *GetLinksByUserId) (*ListLinkResponse, error)
-	UpdateLink(context.Context, *UpdateLinkRequest) (*Empty, error)
+	GetLinksSummary(context.Context, *GetLinksSummaryRequest) (*LinksSummary, error)
+	UpdateLink(context.Context, *UpdateLinkRequest) (*Link, error)
 	DeleteLink(context.Context, *DeleteLinkRequest) (*Empty, error)
-	ListLinks(context.Context, *Empty) (*ListLinkResponse, error)
+	ListLinks(context.Context, *ListLinksRequest) (*ListLinkResponse, error)
+	SearchLinks(context.Context, *SearchLinksRequest) (*SearchLinksResponse, error)
+	ResolveShortCode(context.Context, *ResolveShortCodeRequest) (*Link, error)
+	GetLinkStats(context.Context, *GetLinkStatsRequest) (*LinkStats, error)
+	ListBrokenLinks(context.Context, *ListBrokenLinksRequest) (*ListLinkResponse, error)
+	RestoreLink(context.Context, *RestoreLinkRequest) (*Link, error)
+	ListTrash(context.Context, *ListLinksTrashRequest) (*ListLinkResponse, error)
+	DeleteLinksByUserID(context.Context, *DeleteLinksByUserIDRequest) (*DeleteLinksByUserIDResponse, error)
+	RestoreLinksByUserID(context.Context, *RestoreLinksByUserIDRequest) (*RestoreLinksByUserIDResponse, error)
 	mustEmbedUnimplementedLinkServiceServer()
 }
 
@@ -109,7 +210,7 @@ type LinkServiceServer interface {
 type UnimplementedLinkServiceServer struct {
 }
 
-func (UnimplementedLinkServiceServer) CreateLink(context.Context, *CreateLinkRequest) (*Empty, error) {
+func (UnimplementedLinkServiceServer) CreateLink(context.Context, *CreateLinkRequest) (*Link, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLink not implemented")
 }
 func (UnimplementedLinkServiceServer) GetLink(context.Context, *GetLinkRequest) (*Link, error) {
@@ -118,15 +219,42 @@ func (UnimplementedLinkServiceServer) GetLink(context.Context, *GetLinkRequest)
 func (UnimplementedLinkServiceServer) GetLinkByUserID(context.Context, *GetLinksByUserId) (*ListLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLinkByUserID not implemented")
 }
-func (UnimplementedLinkServiceServer) UpdateLink(context.Context, *UpdateLinkRequest) (*Empty, error) {
+func (UnimplementedLinkServiceServer) GetLinksSummary(context.Context, *GetLinksSummaryRequest) (*LinksSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinksSummary not implemented")
+}
+func (UnimplementedLinkServiceServer) UpdateLink(context.Context, *UpdateLinkRequest) (*Link, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLink not implemented")
 }
 func (UnimplementedLinkServiceServer) DeleteLink(context.Context, *DeleteLinkRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLink not implemented")
 }
-func (UnimplementedLinkServiceServer) ListLinks(context.Context, *Empty) (*ListLinkResponse, error) {
+func (UnimplementedLinkServiceServer) ListLinks(context.Context, *ListLinksRequest) (*ListLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
+func (UnimplementedLinkServiceServer) SearchLinks(context.Context, *SearchLinksRequest) (*SearchLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) ResolveShortCode(context.Context, *ResolveShortCodeRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveShortCode not implemented")
+}
+func (UnimplementedLinkServiceServer) GetLinkStats(context.Context, *GetLinkStatsRequest) (*LinkStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkStats not implemented")
+}
+func (UnimplementedLinkServiceServer) ListBrokenLinks(context.Context, *ListBrokenLinksRequest) (*ListLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrokenLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) RestoreLink(context.Context, *RestoreLinkRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLink not implemented")
+}
+func (UnimplementedLinkServiceServer) ListTrash(context.Context, *ListLinksTrashRequest) (*ListLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedLinkServiceServer) DeleteLinksByUserID(context.Context, *DeleteLinksByUserIDRequest) (*DeleteLinksByUserIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLinksByUserID not implemented")
+}
+func (UnimplementedLinkServiceServer) RestoreLinksByUserID(context.Context, *RestoreLinksByUserIDRequest) (*RestoreLinksByUserIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLinksByUserID not implemented")
+}
 func (UnimplementedLinkServiceServer) mustEmbedUnimplementedLinkServiceServer() {}
 
 // UnsafeLinkServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -194,6 +322,24 @@ func _LinkService_GetLinkByUserID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LinkService_GetLinksSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinksSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetLinksSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/GetLinksSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetLinksSummary(ctx, req.(*GetLinksSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LinkService_UpdateLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateLinkRequest)
 	if err := dec(in); err != nil {
@@ -231,7 +377,7 @@ func _LinkService_DeleteLink_Handler(srv interface{}, ctx context.Context, dec f
 }
 
 func _LinkService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(ListLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -243,7 +389,151 @@ func _LinkService_ListLinks_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: "/pb.LinkService/ListLinks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LinkServiceServer).ListLinks(ctx, req.(*Empty))
+		return srv.(LinkServiceServer).ListLinks(ctx, req.(*ListLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_SearchLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).SearchLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/SearchLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).SearchLinks(ctx, req.(*SearchLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ResolveShortCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShortCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ResolveShortCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ResolveShortCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ResolveShortCode(ctx, req.(*ResolveShortCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_GetLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetLinkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/GetLinkStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetLinkStats(ctx, req.(*GetLinkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ListBrokenLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrokenLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ListBrokenLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ListBrokenLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ListBrokenLinks(ctx, req.(*ListBrokenLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_RestoreLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).RestoreLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/RestoreLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).RestoreLink(ctx, req.(*RestoreLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinksTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ListTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ListTrash(ctx, req.(*ListLinksTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_DeleteLinksByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLinksByUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).DeleteLinksByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/DeleteLinksByUserID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).DeleteLinksByUserID(ctx, req.(*DeleteLinksByUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_RestoreLinksByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLinksByUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).RestoreLinksByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/RestoreLinksByUserID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).RestoreLinksByUserID(ctx, req.(*RestoreLinksByUserIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -267,6 +557,10 @@ var LinkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLinkByUserID",
 			Handler:    _LinkService_GetLinkByUserID_Handler,
 		},
+		{
+			MethodName: "GetLinksSummary",
+			Handler:    _LinkService_GetLinksSummary_Handler,
+		},
 		{
 			MethodName: "UpdateLink",
 			Handler:    _LinkService_UpdateLink_Handler,
@@ -279,6 +573,38 @@ var LinkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLinks",
 			Handler:    _LinkService_ListLinks_Handler,
 		},
+		{
+			MethodName: "SearchLinks",
+			Handler:    _LinkService_SearchLinks_Handler,
+		},
+		{
+			MethodName: "ResolveShortCode",
+			Handler:    _LinkService_ResolveShortCode_Handler,
+		},
+		{
+			MethodName: "GetLinkStats",
+			Handler:    _LinkService_GetLinkStats_Handler,
+		},
+		{
+			MethodName: "ListBrokenLinks",
+			Handler:    _LinkService_ListBrokenLinks_Handler,
+		},
+		{
+			MethodName: "RestoreLink",
+			Handler:    _LinkService_RestoreLink_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _LinkService_ListTrash_Handler,
+		},
+		{
+			MethodName: "DeleteLinksByUserID",
+			Handler:    _LinkService_DeleteLinksByUserID_Handler,
+		},
+		{
+			MethodName: "RestoreLinksByUserID",
+			Handler:    _LinkService_RestoreLinksByUserID_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "links.proto",